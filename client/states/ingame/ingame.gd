@@ -183,6 +183,12 @@ func _handle_spore_msg(sender_id: int, spore_msg: packets.SporeMessage) -> void:
 		var spore := Spore.instantiate(spore_id, x, y, radius, underneath_player)
 		_world.add_child(spore)
 		_spores[spore_id] = spore
+	else:
+		# Ejected spores are moved by the server, which sends where they've got to every tick
+		var spore := _spores[spore_id]
+		spore.x = x
+		spore.y = y
+		spore.position = Vector2(x, y)
 
 func _handle_disconnect_msg(sender_id: int, disconnect_msg: packets.DisconnectMessage) -> void:
 	if sender_id in _players:
@@ -0,0 +1,459 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"server/internal/server"
+	"server/internal/server/auth"
+	"server/internal/server/clients"
+	"server/internal/server/objects"
+	"server/internal/server/profanity"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type config struct {
+	ListenAddr          string
+	TLSCertPath         string
+	TLSKeyPath          string
+	WebSocket           *clients.WebSocketConfig
+	DataPath            string
+	DBPath              string
+	LogLevel            server.LogLevel
+	Rules               *server.Rules
+	RulesPath           string
+	RulesArena          string
+	BannedWordsPath     string
+	ProfanityPolicy     profanity.Policy
+	MuteAfter           int
+	MuteDuration        time.Duration
+	AdminToken          string
+	AccountPolicy       *auth.Policy
+	CommonPasswordsPath string
+	ReservedNames       []string
+	PasswordHasher      *auth.Hasher
+	AuthBackend         string
+	JWTPublicKeyPath    string
+	JWTIssuer           string
+	JWTAudience         string
+	TestAccountsPath    string
+}
+
+var (
+	configPath  = flag.String("config", ".env", "Path to the config file")
+	printConfig = flag.Bool("print-config", false, "Print the settings the server would run with, then exit")
+)
+
+// Settings which are left out of --print-config, since its output tends to end up in logs and bug reports
+var secretSettings = map[string]struct{}{"ADMIN_TOKEN": {}}
+
+// Buffers and queues are allocated for every client, so they're capped to keep a typo from using up all the memory
+const (
+	maxWebSocketBufferSize = 1 << 20
+	maxSendQueueSize       = 1 << 16
+)
+
+// The settings which were given as flags
+var flagKeys = make(map[string]struct{})
+
+// Where a setting came from, each taking precedence over the ones before it
+type settingSource int
+
+const (
+	sourceDefault settingSource = iota
+	sourceConfigFile
+	sourceEnvironment
+	sourceFlag
+)
+
+func sourceOf(key string) settingSource {
+	if _, ok := flagKeys[key]; ok {
+		return sourceFlag
+	}
+	if _, ok := envFileKeys[key]; ok {
+		return sourceConfigFile
+	}
+	if _, ok := os.LookupEnv(key); ok {
+		return sourceEnvironment
+	}
+	return sourceDefault
+}
+
+// Each load starts from fresh defaults, since reloads mustn't share anything with the config being replaced
+func newDefaultConfig() *config {
+	return &config{
+		ListenAddr:          ":8080",
+		WebSocket:           clients.DefaultWebSocketConfig(),
+		LogLevel:            server.LogDebug,
+		Rules:               server.DefaultRules(),
+		RulesPath:           "/gameserver/rules.json",
+		BannedWordsPath:     "/gameserver/banned_words.txt",
+		ProfanityPolicy:     profanity.PolicyMask,
+		MuteAfter:           3,
+		MuteDuration:        10 * time.Minute,
+		AccountPolicy:       auth.NewPolicy(),
+		CommonPasswordsPath: "/gameserver/common_passwords.txt",
+		PasswordHasher:      auth.NewHasher(),
+		AuthBackend:         "database",
+		TestAccountsPath:    "/gameserver/test_accounts.txt",
+	}
+}
+
+// Every setting, in the order --print-config lists them. Each one can be set in the config file, as an environment
+// variable, or with a flag named after it in lower case with dashes, e.g. --listen-addr for LISTEN_ADDR. Flags take
+// precedence over environment variables, which take precedence over the config file.
+var settings = []struct {
+	key   string
+	usage string
+	value func(cfg *config) string
+}{
+	{"LISTEN_ADDR", "Address to listen on", func(cfg *config) string { return cfg.ListenAddr }},
+	{"PORT", "Port to listen on, replacing the one in LISTEN_ADDR unless that was set somewhere which takes precedence", func(cfg *config) string {
+		_, port, _ := net.SplitHostPort(cfg.ListenAddr)
+		return port
+	}},
+	{"TLS_CERT_PATH", "Certificate file to serve HTTPS with, along with TLS_KEY_PATH", func(cfg *config) string { return cfg.TLSCertPath }},
+	{"TLS_KEY_PATH", "Private key file for TLS_CERT_PATH", func(cfg *config) string { return cfg.TLSKeyPath }},
+	{"WS_READ_BUFFER_SIZE", "WebSocket read buffer size in bytes", func(cfg *config) string { return strconv.Itoa(cfg.WebSocket.ReadBufferSize) }},
+	{"WS_WRITE_BUFFER_SIZE", "WebSocket write buffer size in bytes", func(cfg *config) string { return strconv.Itoa(cfg.WebSocket.WriteBufferSize) }},
+	{"SEND_QUEUE_SIZE", "Packets which can wait to be sent to a client before new ones are dropped", func(cfg *config) string { return strconv.Itoa(cfg.WebSocket.SendQueueSize) }},
+	{"ALLOWED_ORIGINS", "Comma separated origins browsers can connect from, or * for any", func(cfg *config) string { return strings.Join(cfg.WebSocket.AllowedOrigins, ",") }},
+	{"REAL_IP_HEADER", "Header a reverse proxy puts the client's address in, like X-Forwarded-For", func(cfg *config) string { return cfg.WebSocket.RealIPHeader }},
+	{"DATA_PATH", "Directory for persistent data", func(cfg *config) string { return cfg.DataPath }},
+	{"DB_PATH", "Database file (default db.sqlite in the data directory)", func(cfg *config) string { return cfg.DBPath }},
+	{"LOG_LEVEL", "debug, info or off", func(cfg *config) string { return cfg.LogLevel.String() }},
+	{"ADMIN_TOKEN", "Bearer token for the admin API, which is disabled without one", func(cfg *config) string { return cfg.AdminToken }},
+	{"RULES_PATH", "JSON file of gameplay rules", func(cfg *config) string { return cfg.RulesPath }},
+	{"RULES_ARENA", "Arena from the rules file to apply", func(cfg *config) string { return cfg.RulesArena }},
+	{"RESPAWN_DELAY", "How long players wait to respawn", func(cfg *config) string { return cfg.Rules.RespawnDelay.String() }},
+	{"GAME_MODE", "ffa, teams or royale", func(cfg *config) string { return cfg.Rules.Mode.String() }},
+	{"TEAM_COUNT", "Number of teams in team games", func(cfg *config) string { return strconv.Itoa(cfg.Rules.TeamCount) }},
+	{"ROUND_COUNTDOWN", "Wait before a battle royale round starts", func(cfg *config) string { return cfg.Rules.RoundCountdown.String() }},
+	{"ROUND_SHRINK_DURATION", "How long the battle royale safe zone takes to shrink", func(cfg *config) string { return cfg.Rules.RoundShrinkDuration.String() }},
+	{"ARENA_SHAPE", "rectangle or circle", func(cfg *config) string { return cfg.Rules.Arena.Shape.String() }},
+	{"ARENA_WIDTH", "Width of a rectangular arena", func(cfg *config) string { return formatFloat(cfg.Rules.Arena.Width) }},
+	{"ARENA_HEIGHT", "Height of a rectangular arena", func(cfg *config) string { return formatFloat(cfg.Rules.Arena.Height) }},
+	{"ARENA_RADIUS", "Radius of a circular arena", func(cfg *config) string { return formatFloat(cfg.Rules.Arena.Radius) }},
+	{"BANNED_WORDS_PATH", "File of banned chat words and phrases", func(cfg *config) string { return cfg.BannedWordsPath }},
+	{"PROFANITY_POLICY", "mask, reject or mute", func(cfg *config) string { return cfg.ProfanityPolicy.String() }},
+	{"PROFANITY_MUTE_AFTER", "Offences before players are muted", func(cfg *config) string { return strconv.Itoa(cfg.MuteAfter) }},
+	{"PROFANITY_MUTE_DURATION", "How long players are muted for", func(cfg *config) string { return cfg.MuteDuration.String() }},
+	{"PASSWORD_MIN_LENGTH", "Fewest characters a password can have", func(cfg *config) string { return strconv.Itoa(cfg.AccountPolicy.MinPasswordLength()) }},
+	{"COMMON_PASSWORDS_PATH", "File of passwords too common to use", func(cfg *config) string { return cfg.CommonPasswordsPath }},
+	{"RESERVED_NAMES", "Comma separated names nobody can register", func(cfg *config) string { return strings.Join(cfg.ReservedNames, ",") }},
+	{"PASSWORD_HASH", "bcrypt or argon2id", func(cfg *config) string { return cfg.PasswordHasher.Algorithm.String() }},
+	{"BCRYPT_COST", "bcrypt cost", func(cfg *config) string { return strconv.Itoa(cfg.PasswordHasher.BcryptCost) }},
+	{"ARGON2_TIME", "argon2id passes", func(cfg *config) string { return strconv.FormatUint(uint64(cfg.PasswordHasher.Argon2Time), 10) }},
+	{"ARGON2_MEMORY", "argon2id memory in KiB", func(cfg *config) string { return strconv.FormatUint(uint64(cfg.PasswordHasher.Argon2Memory), 10) }},
+	{"ARGON2_THREADS", "argon2id threads", func(cfg *config) string { return strconv.Itoa(int(cfg.PasswordHasher.Argon2Threads)) }},
+	{"AUTH_BACKEND", "database, jwt or file", func(cfg *config) string { return cfg.AuthBackend }},
+	{"JWT_PUBLIC_KEY_PATH", "PEM file with the identity service's public key", func(cfg *config) string { return cfg.JWTPublicKeyPath }},
+	{"JWT_ISSUER", "iss claim tokens must have", func(cfg *config) string { return cfg.JWTIssuer }},
+	{"JWT_AUDIENCE", "aud claim tokens must include", func(cfg *config) string { return cfg.JWTAudience }},
+	{"TEST_ACCOUNTS_PATH", "File of username:password test accounts", func(cfg *config) string { return cfg.TestAccountsPath }},
+}
+
+func settingFlagName(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "_", "-")
+}
+
+// Adds a flag for every setting. Must be called before flag.Parse.
+func defineSettingFlags() {
+	for _, s := range settings {
+		flag.String(settingFlagName(s.key), "", s.usage)
+	}
+}
+
+// Copies the settings given as flags into the environment, where they override everything else
+func applySettingFlags() {
+	keys := make(map[string]string, len(settings))
+	for _, s := range settings {
+		keys[settingFlagName(s.key)] = s.key
+	}
+	flag.Visit(func(f *flag.Flag) {
+		if key, ok := keys[f.Name]; ok {
+			os.Setenv(key, f.Value.String())
+			flagKeys[key] = struct{}{}
+		}
+	})
+}
+
+// Writes the settings in the config file's format, so the output can be saved as one. Secret settings are only
+// mentioned in a comment, so they have to be added back by hand.
+func writeConfig(w io.Writer, cfg *config) {
+	for _, s := range settings {
+		value := s.value(cfg)
+		if _, secret := secretSettings[s.key]; secret && value != "" {
+			fmt.Fprintf(w, "# %s is set\n", s.key)
+			continue
+		}
+		fmt.Fprintf(w, "%s=%s\n", s.key, value)
+	}
+}
+
+func formatFloat(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+// Reads the settings from the environment on top of the defaults. Every invalid setting is reported, rather than
+// falling back to its default, so mistakes can't go unnoticed.
+func loadConfig() (*config, error) {
+	cfg := newDefaultConfig()
+	var errs []error
+
+	// The rules file is the base, and the environment variables below override it
+	if path, ok := os.LookupEnv("RULES_PATH"); ok {
+		cfg.RulesPath = path
+	}
+	cfg.RulesArena = os.Getenv("RULES_ARENA")
+	rules, err := server.LoadRules(cfg.RulesPath, cfg.RulesArena)
+	if err == nil {
+		log.Printf("Loaded the rules from %s", cfg.RulesPath)
+		cfg.Rules = rules
+	} else if errors.Is(err, fs.ErrNotExist) && cfg.RulesArena == "" {
+		log.Printf("No rules file at %s, using the default rules", cfg.RulesPath)
+	} else {
+		return nil, fmt.Errorf("error loading the rules from %s: %w", cfg.RulesPath, err)
+	}
+
+	if addr, ok := os.LookupEnv("LISTEN_ADDR"); ok {
+		cfg.ListenAddr = addr
+	}
+	host, port, err := net.SplitHostPort(cfg.ListenAddr)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("LISTEN_ADDR must be a host and port like :8080: %w", err))
+	}
+	// PORT is the more specific of the two, but it mustn't undo a LISTEN_ADDR given somewhere which takes precedence
+	if value, ok := os.LookupEnv("PORT"); ok && sourceOf("PORT") >= sourceOf("LISTEN_ADDR") {
+		port = value
+		cfg.ListenAddr = net.JoinHostPort(host, port)
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		errs = append(errs, fmt.Errorf("the port must be a number from 0 to 65535, not %q", port))
+	}
+	cfg.TLSCertPath = os.Getenv("TLS_CERT_PATH")
+	cfg.TLSKeyPath = os.Getenv("TLS_KEY_PATH")
+	if (cfg.TLSCertPath == "") != (cfg.TLSKeyPath == "") {
+		errs = append(errs, errors.New("TLS_CERT_PATH and TLS_KEY_PATH must be set together"))
+	}
+	errs = append(errs,
+		lookupIntRangeEnv("WS_READ_BUFFER_SIZE", &cfg.WebSocket.ReadBufferSize, 1, maxWebSocketBufferSize),
+		lookupIntRangeEnv("WS_WRITE_BUFFER_SIZE", &cfg.WebSocket.WriteBufferSize, 1, maxWebSocketBufferSize),
+		lookupIntRangeEnv("SEND_QUEUE_SIZE", &cfg.WebSocket.SendQueueSize, 1, maxSendQueueSize),
+	)
+	if origins, ok := os.LookupEnv("ALLOWED_ORIGINS"); ok {
+		cfg.WebSocket.AllowedOrigins = splitList(origins)
+		if len(cfg.WebSocket.AllowedOrigins) == 0 {
+			errs = append(errs, errors.New("ALLOWED_ORIGINS must list at least one origin, or * for any"))
+		}
+	}
+	cfg.WebSocket.RealIPHeader = os.Getenv("REAL_IP_HEADER")
+	cfg.DataPath = os.Getenv("DATA_PATH")
+	cfg.DBPath = os.Getenv("DB_PATH")
+	if level, ok := os.LookupEnv("LOG_LEVEL"); ok {
+		logLevel, err := server.ParseLogLevel(level)
+		errs = append(errs, prefixError("LOG_LEVEL", err))
+		cfg.LogLevel = logLevel
+	}
+	cfg.AdminToken = os.Getenv("ADMIN_TOKEN")
+
+	errs = append(errs, lookupDurationEnv("RESPAWN_DELAY", &cfg.Rules.RespawnDelay))
+	if mode, ok := os.LookupEnv("GAME_MODE"); ok {
+		gameMode, err := server.ParseGameMode(mode)
+		errs = append(errs, prefixError("GAME_MODE", err))
+		cfg.Rules.Mode = gameMode
+	}
+	errs = append(errs,
+		lookupIntEnv("TEAM_COUNT", &cfg.Rules.TeamCount, 2),
+		lookupDurationEnv("ROUND_COUNTDOWN", &cfg.Rules.RoundCountdown),
+		lookupDurationEnv("ROUND_SHRINK_DURATION", &cfg.Rules.RoundShrinkDuration),
+	)
+	if shape, ok := os.LookupEnv("ARENA_SHAPE"); ok {
+		arenaShape, err := objects.ParseArenaShape(shape)
+		errs = append(errs, prefixError("ARENA_SHAPE", err))
+		cfg.Rules.Arena.Shape = arenaShape
+	}
+	errs = append(errs,
+		lookupFloatEnv("ARENA_WIDTH", &cfg.Rules.Arena.Width),
+		lookupFloatEnv("ARENA_HEIGHT", &cfg.Rules.Arena.Height),
+		lookupFloatEnv("ARENA_RADIUS", &cfg.Rules.Arena.Radius),
+	)
+
+	if path, ok := os.LookupEnv("BANNED_WORDS_PATH"); ok {
+		cfg.BannedWordsPath = path
+	}
+	if policy, ok := os.LookupEnv("PROFANITY_POLICY"); ok {
+		profanityPolicy, err := profanity.ParsePolicy(policy)
+		errs = append(errs, prefixError("PROFANITY_POLICY", err))
+		cfg.ProfanityPolicy = profanityPolicy
+	}
+	errs = append(errs,
+		lookupIntEnv("PROFANITY_MUTE_AFTER", &cfg.MuteAfter, 1),
+		lookupDurationEnv("PROFANITY_MUTE_DURATION", &cfg.MuteDuration),
+	)
+	minPasswordLength := cfg.AccountPolicy.MinPasswordLength()
+	errs = append(errs, lookupIntEnv("PASSWORD_MIN_LENGTH", &minPasswordLength, 1))
+	cfg.AccountPolicy.SetMinPasswordLength(minPasswordLength)
+	if path, ok := os.LookupEnv("COMMON_PASSWORDS_PATH"); ok {
+		cfg.CommonPasswordsPath = path
+	}
+	cfg.ReservedNames = splitList(os.Getenv("RESERVED_NAMES"))
+	cfg.AccountPolicy.Reserve(cfg.ReservedNames...)
+
+	if algorithm, ok := os.LookupEnv("PASSWORD_HASH"); ok {
+		hashAlgorithm, err := auth.ParseHashAlgorithm(algorithm)
+		errs = append(errs, prefixError("PASSWORD_HASH", err))
+		cfg.PasswordHasher.Algorithm = hashAlgorithm
+	}
+	errs = append(errs, lookupIntEnv("BCRYPT_COST", &cfg.PasswordHasher.BcryptCost, bcrypt.MinCost))
+	if cfg.PasswordHasher.BcryptCost > bcrypt.MaxCost {
+		errs = append(errs, fmt.Errorf("BCRYPT_COST can't be more than %d", bcrypt.MaxCost))
+	}
+	errs = append(errs,
+		lookupUintEnv("ARGON2_TIME", &cfg.PasswordHasher.Argon2Time),
+		lookupUintEnv("ARGON2_MEMORY", &cfg.PasswordHasher.Argon2Memory),
+	)
+	if threads, ok := os.LookupEnv("ARGON2_THREADS"); ok {
+		count, err := strconv.ParseUint(threads, 10, 8)
+		if err != nil || count < 1 {
+			errs = append(errs, fmt.Errorf("ARGON2_THREADS must be a number from 1 to 255, not %q", threads))
+		}
+		cfg.PasswordHasher.Argon2Threads = uint8(count)
+	}
+
+	if backend, ok := os.LookupEnv("AUTH_BACKEND"); ok {
+		cfg.AuthBackend = backend
+	}
+	switch cfg.AuthBackend {
+	case "database", "jwt", "file":
+	default:
+		errs = append(errs, fmt.Errorf("unknown AUTH_BACKEND %q, expected database, jwt or file", cfg.AuthBackend))
+	}
+	cfg.JWTPublicKeyPath = os.Getenv("JWT_PUBLIC_KEY_PATH")
+	if cfg.AuthBackend == "jwt" && cfg.JWTPublicKeyPath == "" {
+		errs = append(errs, errors.New("JWT_PUBLIC_KEY_PATH must be set to log in with JWTs"))
+	}
+	cfg.JWTIssuer = os.Getenv("JWT_ISSUER")
+	cfg.JWTAudience = os.Getenv("JWT_AUDIENCE")
+	if path, ok := os.LookupEnv("TEST_ACCOUNTS_PATH"); ok {
+		cfg.TestAccountsPath = path
+	}
+
+	errs = append(errs, cfg.Rules.Validate())
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Where the database is kept, once the data directory's been found
+func (cfg *config) dbPath(dataPath string) string {
+	if cfg.DBPath != "" {
+		return cfg.DBPath
+	}
+	return path.Join(dataPath, "db.sqlite")
+}
+
+// What the chat filter should do about banned words
+func (cfg *config) filterSettings() profanity.Settings {
+	return profanity.Settings{Policy: cfg.ProfanityPolicy, MuteAfter: cfg.MuteAfter, MuteDuration: cfg.MuteDuration}
+}
+
+func prefixError(key string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", key, err)
+}
+
+// Splits a comma separated list, leaving out blank entries
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Overwrites the target with the environment variable's duration, if it's set
+func lookupDurationEnv(key string, target *time.Duration) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s must be a duration like 30s or 5m, not %q", key, value)
+	}
+	*target = duration
+	return nil
+}
+
+// Overwrites the target with the environment variable's number, if it's set
+func lookupFloatEnv(key string, target *float64) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("%s must be a number, not %q", key, value)
+	}
+	*target = number
+	return nil
+}
+
+// Overwrites the target with the environment variable's whole number, if it's set and at least the minimum
+func lookupIntEnv(key string, target *int, minimum int) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < minimum {
+		return fmt.Errorf("%s must be a whole number of at least %d, not %q", key, minimum, value)
+	}
+	*target = number
+	return nil
+}
+
+// Overwrites the target with the environment variable's whole number, if it's set and from the minimum to the maximum
+func lookupIntRangeEnv(key string, target *int, minimum int, maximum int) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < minimum || number > maximum {
+		return fmt.Errorf("%s must be a whole number from %d to %d, not %q", key, minimum, maximum, value)
+	}
+	*target = number
+	return nil
+}
+
+// Overwrites the target with the environment variable's positive whole number, if it's set
+func lookupUintEnv(key string, target *uint32) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	number, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || number < 1 {
+		return fmt.Errorf("%s must be a positive whole number, not %q", key, value)
+	}
+	*target = uint32(number)
+	return nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Where each setting comes from in a test, standing in for the config file, the environment and flags
+type testSources struct {
+	file  map[string]string
+	env   map[string]string
+	flags map[string]string
+}
+
+// Sets the settings up the way main does, and puts everything back once the test's done
+func loadTestConfig(t *testing.T, sources testSources) (*config, error) {
+	t.Helper()
+	t.Setenv("RULES_PATH", filepath.Join(t.TempDir(), "missing.json"))
+
+	savedFlagKeys, savedStartupEnv, savedEnvFileKeys := flagKeys, startupEnv, envFileKeys
+	flagKeys, startupEnv, envFileKeys = make(map[string]struct{}), make(map[string]struct{}), make(map[string]struct{})
+	t.Cleanup(func() {
+		for key := range envFileKeys {
+			os.Unsetenv(key)
+		}
+		flagKeys, startupEnv, envFileKeys = savedFlagKeys, savedStartupEnv, savedEnvFileKeys
+	})
+
+	for key, value := range sources.env {
+		t.Setenv(key, value)
+	}
+	for key, value := range sources.flags {
+		t.Setenv(key, value)
+		flagKeys[key] = struct{}{}
+	}
+	rememberStartupEnv()
+
+	var lines []string
+	for key, value := range sources.file {
+		lines = append(lines, key+"="+value)
+	}
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadEnvFile(path); err != nil {
+		t.Fatal(err)
+	}
+	return loadConfig()
+}
+
+func TestConfigPrecedence(t *testing.T) {
+	tests := []struct {
+		name       string
+		sources    testSources
+		wantListen string
+	}{
+		{"default", testSources{}, ":8080"},
+		{"config file", testSources{file: map[string]string{"LISTEN_ADDR": "127.0.0.1:9000"}}, "127.0.0.1:9000"},
+		{"environment over config file", testSources{
+			file: map[string]string{"LISTEN_ADDR": "127.0.0.1:9000"},
+			env:  map[string]string{"LISTEN_ADDR": "127.0.0.1:9001"},
+		}, "127.0.0.1:9001"},
+		{"flag over environment", testSources{
+			env:   map[string]string{"LISTEN_ADDR": "127.0.0.1:9001"},
+			flags: map[string]string{"LISTEN_ADDR": "127.0.0.1:9002"},
+		}, "127.0.0.1:9002"},
+		{"port replaces the default port", testSources{file: map[string]string{"PORT": "9000"}}, ":9000"},
+		{"port from the same place replaces the port", testSources{
+			file: map[string]string{"LISTEN_ADDR": "127.0.0.1:9000", "PORT": "9001"},
+		}, "127.0.0.1:9001"},
+		{"port from the environment replaces a config file's port", testSources{
+			file: map[string]string{"LISTEN_ADDR": "127.0.0.1:9000"},
+			env:  map[string]string{"PORT": "9001"},
+		}, "127.0.0.1:9001"},
+		{"port from the config file doesn't replace a flag's", testSources{
+			file:  map[string]string{"PORT": "9000"},
+			flags: map[string]string{"LISTEN_ADDR": "127.0.0.1:9002"},
+		}, "127.0.0.1:9002"},
+		{"port from the environment doesn't replace a flag's", testSources{
+			env:   map[string]string{"PORT": "9001"},
+			flags: map[string]string{"LISTEN_ADDR": "127.0.0.1:9002"},
+		}, "127.0.0.1:9002"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := loadTestConfig(t, test.sources)
+			if err != nil {
+				t.Fatalf("loadConfig() error = %v", err)
+			}
+			if cfg.ListenAddr != test.wantListen {
+				t.Errorf("ListenAddr = %q, want %q", cfg.ListenAddr, test.wantListen)
+			}
+		})
+	}
+}
+
+func TestConfigInvalid(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+	}{
+		{"LISTEN_ADDR", "8080"},
+		{"PORT", "70000"},
+		{"PORT", "http"},
+		{"WS_READ_BUFFER_SIZE", "0"},
+		{"WS_READ_BUFFER_SIZE", "2000000"},
+		{"WS_WRITE_BUFFER_SIZE", "2000000"},
+		{"SEND_QUEUE_SIZE", "100000"},
+		{"SEND_QUEUE_SIZE", "lots"},
+		{"ALLOWED_ORIGINS", " , "},
+		{"LOG_LEVEL", "verbose"},
+		{"RESPAWN_DELAY", "3"},
+		{"GAME_MODE", "capture the flag"},
+		{"PROFANITY_POLICY", "ignore"},
+		{"PROFANITY_MUTE_AFTER", "0"},
+		{"PASSWORD_MIN_LENGTH", "-1"},
+		{"PASSWORD_HASH", "md5"},
+		{"BCRYPT_COST", "99"},
+		{"ARGON2_THREADS", "256"},
+		{"AUTH_BACKEND", "ldap"},
+		{"TLS_CERT_PATH", "cert.pem"},
+	}
+	for _, test := range tests {
+		t.Run(test.key+"="+test.value, func(t *testing.T) {
+			_, err := loadTestConfig(t, testSources{env: map[string]string{test.key: test.value}})
+			if err == nil || !strings.Contains(err.Error(), test.key) && !strings.Contains(err.Error(), "port") {
+				t.Errorf("loadConfig() error = %v, want one about %s", err, test.key)
+			}
+		})
+	}
+
+	// Every mistake is reported, not just the first
+	_, err := loadTestConfig(t, testSources{env: map[string]string{"SEND_QUEUE_SIZE": "0", "LOG_LEVEL": "verbose"}})
+	if err == nil || !strings.Contains(err.Error(), "SEND_QUEUE_SIZE") || !strings.Contains(err.Error(), "LOG_LEVEL") {
+		t.Errorf("loadConfig() error = %v, want one about both settings", err)
+	}
+}
+
+func TestWriteConfigHidesSecrets(t *testing.T) {
+	cfg, err := loadTestConfig(t, testSources{env: map[string]string{"ADMIN_TOKEN": "s3cret"}})
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	var output strings.Builder
+	writeConfig(&output, cfg)
+	if strings.Contains(output.String(), "s3cret") || strings.Contains(output.String(), "ADMIN_TOKEN=") {
+		t.Errorf("writeConfig() printed the admin token:\n%s", output.String())
+	}
+	if !strings.Contains(output.String(), "# ADMIN_TOKEN is set\n") {
+		t.Errorf("writeConfig() didn't mention the admin token is set:\n%s", output.String())
+	}
+
+	// The output can be loaded back as a config file
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(output.String()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	os.Unsetenv("ADMIN_TOKEN")
+	if err := loadEnvFile(path); err != nil {
+		t.Fatalf("loadEnvFile() error = %v", err)
+	}
+	reloaded, err := loadConfig()
+	if err != nil {
+		t.Fatalf("loadConfig() of the printed config error = %v", err)
+	}
+	if reloaded.AdminToken != "" || reloaded.ListenAddr != cfg.ListenAddr {
+		t.Errorf("printed config loaded back with admin token %q and listen address %q", reloaded.AdminToken, reloaded.ListenAddr)
+	}
+}
@@ -1,17 +1,18 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"io/fs"
 	"log"
 	"net/http"
 	"os"
-	"strconv"
+	"time"
 
 	"server/internal/server"
-	"server/internal/server/clients"
-
-	"github.com/joho/godotenv"
+	"server/internal/server/auth"
+	"server/internal/server/profanity"
 )
 
 // If the server is running in a Docker container, the data directory is always mounted here:
@@ -19,30 +20,30 @@ const (
 	dockerMountedDataDir = "/gameserver/data"
 )
 
-type config struct {
-	DataPath string
-	Port     int
-}
-
-var (
-	defaultConfig = &config{Port: 8080}
-	configPath    = flag.String("config", ".env", "Path to the config file")
-)
-
-func loadConfig() *config {
-	cfg := defaultConfig
-	cfg.DataPath = os.Getenv("DATA_PATH")
-	fmt.Println(cfg.DataPath)
-	port, err := strconv.Atoi(os.Getenv("PORT"))
-	fmt.Println(port)
-	if err != nil {
-		log.Printf("Error parsing PORT, using %d", cfg.Port)
-		fmt.Println(err)
-		return cfg
+// Sets up the configured authentication backend, or returns nil to check passwords against the database
+func newAuthenticator(cfg *config) auth.Authenticator {
+	switch cfg.AuthBackend {
+	case "database":
+		return nil
+	case "jwt":
+		authenticator, err := auth.NewJWTAuthenticator(cfg.JWTPublicKeyPath)
+		if err != nil {
+			log.Fatalf("Error loading the JWT public key: %v", err)
+		}
+		authenticator.Issuer = cfg.JWTIssuer
+		authenticator.Audience = cfg.JWTAudience
+		return authenticator
+	case "file":
+		authenticator, err := auth.NewFileAuthenticator(cfg.TestAccountsPath)
+		if err != nil {
+			log.Fatalf("Error loading the test accounts: %v", err)
+		}
+		log.Printf("Logging in the test accounts from %s - don't use this in production", cfg.TestAccountsPath)
+		return authenticator
+	default:
+		log.Fatalf("Unknown AUTH_BACKEND %q, expected database, jwt or file", cfg.AuthBackend)
+		return nil
 	}
-	cfg.Port = port
-	fmt.Println(cfg.Port)
-	return cfg
 }
 
 func coalescePaths(fallbacks ...string) string {
@@ -63,32 +64,67 @@ func coalescePaths(fallbacks ...string) string {
 }
 
 func main() {
+	defineSettingFlags()
 	flag.Parse()
-	err := godotenv.Load(*configPath)
-	cfg := defaultConfig
+	applySettingFlags()
+	rememberStartupEnv()
+	if err := loadEnvFile(*configPath); err != nil {
+		// Without a config file everything comes from the environment, which is how Docker passes it in, but a
+		// config file which was asked for has to be there
+		configGiven := false
+		flag.Visit(func(f *flag.Flag) { configGiven = configGiven || f.Name == "config" })
+		if configGiven || !errors.Is(err, fs.ErrNotExist) {
+			log.Fatalf("Error loading the config file: %v", err)
+		}
+		log.Printf("No config file at %s, using the environment", *configPath)
+	}
+	cfg, err := loadConfig()
 	if err != nil {
-		log.Printf("Error loading config file, defaulting to %+v", defaultConfig)
-	} else {
-		cfg = loadConfig()
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	if *printConfig {
+		writeConfig(os.Stdout, cfg)
+		return
 	}
+	server.SetLogLevel(cfg.LogLevel)
 
 	// Try to load the Docker-mounted data directory. If that fails,
 	// fall back to the current directory
-	cfg.DataPath = coalescePaths(cfg.DataPath, dockerMountedDataDir, ".")
-	hub := server.NewHub(cfg.DataPath)
+	dataPath := coalescePaths(cfg.DataPath, dockerMountedDataDir, ".")
+	chatFilter := profanity.NewFilter(cfg.BannedWordsPath)
+	chatFilter.SetSettings(cfg.filterSettings())
+	if err := cfg.AccountPolicy.LoadCommonPasswords(cfg.CommonPasswordsPath); err != nil {
+		log.Printf("Error loading common passwords, they won't be rejected: %v", err)
+	}
+	hub := server.NewHub(cfg.dbPath(dataPath), cfg.Rules, chatFilter, cfg.AccountPolicy, cfg.PasswordHasher, newAuthenticator(cfg))
 
 	// Define handler for WebSocket connections
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		hub.Serve(clients.NewWebSocketClient, w, r)
+		hub.Serve(cfg.WebSocket.NewClient, w, r)
 	})
+	if cfg.AdminToken != "" {
+		http.Handle("/admin/", hub.AdminHandler(cfg.AdminToken))
+	} else {
+		log.Println("ADMIN_TOKEN not set, the admin API is disabled")
+	}
+
+	// Settings can be changed without restarting by editing the config or rules file, sending the server a SIGHUP,
+	// or through the admin API
+	reloader := &configReloader{hub: hub, current: cfg}
+	hub.ReloadConfig = reloader.Reload
+	go reloader.watchFiles(5 * time.Second)
+	go reloader.reloadOnHangup()
 
 	// Start the server
 	go hub.Run()
-	addr := fmt.Sprintf(":%d", cfg.Port)
-
-	log.Printf("Starting server on %s", addr)
 
-	err = http.ListenAndServe(addr, nil)
+	if cfg.TLSCertPath != "" {
+		log.Printf("Starting server on %s with TLS", cfg.ListenAddr)
+		err = http.ListenAndServeTLS(cfg.ListenAddr, cfg.TLSCertPath, cfg.TLSKeyPath, nil)
+	} else {
+		log.Printf("Starting server on %s", cfg.ListenAddr)
+		err = http.ListenAndServe(cfg.ListenAddr, nil)
+	}
 	if err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
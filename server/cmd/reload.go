@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"server/internal/server"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// The variables which were already set when the server started. They take precedence over the config file, so
+// reloading it mustn't overwrite them.
+var startupEnv = make(map[string]struct{})
+
+// The variables the config file set last time it was loaded, so they can be unset if they're taken out of it
+var envFileKeys = make(map[string]struct{})
+
+func rememberStartupEnv() {
+	for _, entry := range os.Environ() {
+		key, _, _ := strings.Cut(entry, "=")
+		startupEnv[key] = struct{}{}
+	}
+}
+
+// Sets the environment variables from the config file, except for those the server was started with
+func loadEnvFile(path string) error {
+	values, err := godotenv.Read(path)
+	if err != nil {
+		return err
+	}
+	for key := range envFileKeys {
+		if _, kept := values[key]; !kept {
+			os.Unsetenv(key)
+		}
+	}
+	envFileKeys = make(map[string]struct{})
+	for key, value := range values {
+		if _, set := startupEnv[key]; set {
+			continue
+		}
+		os.Setenv(key, value)
+		envFileKeys[key] = struct{}{}
+	}
+	return nil
+}
+
+// Applies changes to the configuration while the server's running, one reload at a time
+type configReloader struct {
+	hub     *server.Hub
+	current *config
+	mux     sync.Mutex
+}
+
+// Loads the config and rules files again, and if they're valid, applies the settings which can safely change with
+// players connected. Settings which need a restart keep their current values, and are logged so nobody's left
+// wondering why they didn't take.
+func (r *configReloader) Reload() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if err := loadEnvFile(*configPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("Not reloading the configuration: %v", err)
+		return err
+	}
+	next, err := loadConfig()
+	if err != nil {
+		log.Printf("Not reloading the configuration, it's invalid: %v", err)
+		return err
+	}
+
+	restartOnly := []struct {
+		setting string
+		changed bool
+	}{
+		{"LISTEN_ADDR and PORT", next.ListenAddr != r.current.ListenAddr},
+		{"the TLS certificate", next.TLSCertPath != r.current.TLSCertPath || next.TLSKeyPath != r.current.TLSKeyPath},
+		{"the WebSocket settings", !reflect.DeepEqual(next.WebSocket, r.current.WebSocket)},
+		{"DATA_PATH", next.DataPath != r.current.DataPath},
+		{"DB_PATH", next.DBPath != r.current.DBPath},
+		{"LOG_LEVEL", next.LogLevel != r.current.LogLevel},
+		{"ADMIN_TOKEN", next.AdminToken != r.current.AdminToken},
+		{"BANNED_WORDS_PATH", next.BannedWordsPath != r.current.BannedWordsPath},
+		{"COMMON_PASSWORDS_PATH", next.CommonPasswordsPath != r.current.CommonPasswordsPath},
+		{"RESERVED_NAMES", !slices.Equal(next.ReservedNames, r.current.ReservedNames)},
+		{"the password hash settings", *next.PasswordHasher != *r.current.PasswordHasher},
+		{"AUTH_BACKEND", next.AuthBackend != r.current.AuthBackend},
+		{"the JWT settings", next.JWTPublicKeyPath != r.current.JWTPublicKeyPath ||
+			next.JWTIssuer != r.current.JWTIssuer || next.JWTAudience != r.current.JWTAudience},
+		{"TEST_ACCOUNTS_PATH", next.TestAccountsPath != r.current.TestAccountsPath},
+	}
+	for _, s := range restartOnly {
+		if s.changed {
+			log.Printf("Changing %s needs a restart, so it won't take effect until then", s.setting)
+		}
+	}
+
+	r.hub.SetRules(next.Rules)
+	r.current.Rules = r.hub.Rules()
+	r.current.RulesPath = next.RulesPath
+	r.current.RulesArena = next.RulesArena
+
+	r.hub.ChatFilter.SetSettings(next.filterSettings())
+	if err := r.hub.ChatFilter.Reload(); err != nil {
+		log.Printf("Error reloading banned words: %v", err)
+	}
+	r.current.ProfanityPolicy = next.ProfanityPolicy
+	r.current.MuteAfter = next.MuteAfter
+	r.current.MuteDuration = next.MuteDuration
+
+	r.hub.AccountPolicy.SetMinPasswordLength(next.AccountPolicy.MinPasswordLength())
+
+	log.Println("Reloaded the configuration")
+	return nil
+}
+
+// Reloads the configuration whenever the config or rules file changes, checking every interval
+func (r *configReloader) watchFiles(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	modTimes := make(map[string]time.Time)
+	changed := func() bool {
+		r.mux.Lock()
+		paths := []string{*configPath, r.current.RulesPath}
+		r.mux.Unlock()
+
+		anyChanged := false
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			if err != nil {
+				continue
+			}
+			if previous, seen := modTimes[path]; seen && !info.ModTime().Equal(previous) {
+				anyChanged = true
+			}
+			modTimes[path] = info.ModTime()
+		}
+		return anyChanged
+	}
+
+	changed()
+	for range ticker.C {
+		if changed() {
+			r.Reload()
+		}
+	}
+}
+
+func (r *configReloader) reloadOnHangup() {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	for range hangups {
+		log.Println("Received SIGHUP, reloading the configuration")
+		r.Reload()
+	}
+}
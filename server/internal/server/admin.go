@@ -0,0 +1,280 @@
+package server
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strconv"
+	"time"
+)
+
+// Bans without a duration last until this time, which is as good as forever
+var permanentBan = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+type reportView struct {
+	Id           int64      `json:"id"`
+	Reporter     string     `json:"reporter,omitempty"`
+	Target       string     `json:"target"`
+	Reason       string     `json:"reason"`
+	Text         string     `json:"text"`
+	ChatSnapshot string     `json:"chat_snapshot"`
+	BestScore    int64      `json:"best_score"`
+	Mass         float64    `json:"mass"`
+	Status       string     `json:"status"`
+	Resolution   string     `json:"resolution,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+}
+
+type resolveRequest struct {
+	// One of dismiss, mute or ban
+	Action string `json:"action"`
+
+	// How long the mute or ban lasts, as a Go duration. Bans without one are permanent.
+	Duration string `json:"duration"`
+
+	Note string `json:"note"`
+}
+
+// The HTTP API moderators use to work through player reports and admins use to reload the configuration. Every
+// request needs the token as a bearer token.
+func (h *Hub) AdminHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/reports", h.handleListReports)
+	mux.HandleFunc("POST /admin/reports/{id}/resolve", h.handleResolveReport)
+	mux.HandleFunc("POST /admin/reload", h.handleReload)
+
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (h *Hub) handleListReports(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status == "" {
+		status = "open"
+	}
+	limit, err := queryInt(r, "limit", 50)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	offset, err := queryInt(r, "offset", 0)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dbTx := h.NewDbTx()
+	reports, err := dbTx.Queries.ListReports(dbTx.Ctx, db.ListReportsParams{
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		log.Printf("Error listing reports: %v", err)
+		http.Error(w, "failed to list reports", http.StatusInternalServerError)
+		return
+	}
+
+	views := make([]reportView, 0, len(reports))
+	for _, report := range reports {
+		view := reportView{
+			Id:           report.ID,
+			Reporter:     report.ReporterName.String,
+			Target:       report.TargetName,
+			Reason:       packets.ReportReason(report.Reason).String(),
+			Text:         report.Text,
+			ChatSnapshot: report.ChatSnapshot,
+			BestScore:    report.BestScore,
+			Mass:         report.Mass,
+			Status:       report.Status,
+			Resolution:   report.Resolution,
+			CreatedAt:    report.CreatedAt,
+		}
+		if report.ResolvedAt.Valid {
+			view.ResolvedAt = &report.ResolvedAt.Time
+		}
+		views = append(views, view)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(views); err != nil {
+		log.Printf("Error writing reports: %v", err)
+	}
+}
+
+func (h *Hub) handleResolveReport(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid report id", http.StatusBadRequest)
+		return
+	}
+	var request resolveRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	var duration time.Duration
+	if request.Duration != "" {
+		duration, err = time.ParseDuration(request.Duration)
+		if err != nil || duration <= 0 {
+			http.Error(w, "invalid duration", http.StatusBadRequest)
+			return
+		}
+	}
+
+	dbTx := h.NewDbTx()
+	report, err := dbTx.Queries.GetReport(dbTx.Ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		http.Error(w, "report not found", http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Printf("Error getting report %d: %v", id, err)
+		http.Error(w, "failed to get report", http.StatusInternalServerError)
+		return
+	}
+	if report.Status != "open" {
+		http.Error(w, fmt.Sprintf("report was already resolved: %s", report.Resolution), http.StatusConflict)
+		return
+	}
+
+	var resolution string
+	switch request.Action {
+	case "dismiss":
+		resolution = "dismissed"
+	case "mute":
+		if duration == 0 {
+			http.Error(w, "mutes need a duration", http.StatusBadRequest)
+			return
+		}
+		err = h.mutePlayer(report.TargetPlayerID, time.Now().Add(duration))
+		resolution = fmt.Sprintf("muted for %v", duration)
+	case "ban":
+		until := permanentBan
+		resolution = "banned permanently"
+		if duration > 0 {
+			until = time.Now().Add(duration)
+			resolution = fmt.Sprintf("banned for %v", duration)
+		}
+		err = h.banPlayer(report.TargetPlayerID, until)
+	default:
+		http.Error(w, "action must be dismiss, mute or ban", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Printf("Error acting on report %d: %v", id, err)
+		http.Error(w, "failed to act on report", http.StatusInternalServerError)
+		return
+	}
+
+	if request.Note != "" {
+		resolution += ": " + request.Note
+	}
+	err = dbTx.Queries.ResolveReport(dbTx.Ctx, db.ResolveReportParams{
+		Resolution: resolution,
+		ID:         id,
+	})
+	if err != nil {
+		log.Printf("Error resolving report %d: %v", id, err)
+		http.Error(w, "failed to resolve report", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("Report %d resolved: %s", id, resolution)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Hub) handleReload(w http.ResponseWriter, r *http.Request) {
+	if h.ReloadConfig == nil {
+		http.Error(w, "reloading isn't supported", http.StatusNotImplemented)
+		return
+	}
+	if err := h.ReloadConfig(); err != nil {
+		http.Error(w, fmt.Sprintf("invalid configuration, nothing was changed: %v", err), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Hub) mutePlayer(playerDbId int64, until time.Time) error {
+	dbTx := h.NewDbTx()
+	err := dbTx.Queries.MutePlayer(dbTx.Ctx, db.MutePlayerParams{
+		MutedUntil: sql.NullTime{Time: until, Valid: true},
+		ID:         playerDbId,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Online players keep their mute in memory, so their client needs to update it there too. By the time it gets
+	// to it they could have logged out, or back in as someone else.
+	if clientId, _, online := h.findOnlinePlayer(playerDbId); online {
+		if client, exists := h.Clients.Get(clientId); exists {
+			client.Enqueue(func() {
+				if player, exists := client.OnlinePlayers().Get(clientId); exists && player.DbId == playerDbId {
+					player.MutedUntil = until
+				}
+			})
+		}
+	}
+	return nil
+}
+
+func (h *Hub) banPlayer(playerDbId int64, until time.Time) error {
+	dbTx := h.NewDbTx()
+	err := dbTx.Queries.BanPlayer(dbTx.Ctx, db.BanPlayerParams{
+		BannedUntil: sql.NullTime{Time: until, Valid: true},
+		ID:          playerDbId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if clientId, _, online := h.findOnlinePlayer(playerDbId); online {
+		// Their client lets them know, then closes itself once that's been sent
+		if client, exists := h.Clients.Get(clientId); exists {
+			client.Enqueue(func() {
+				client.SocketSendAs(packets.NewChat(fmt.Sprintf("You've been banned until %s", until.Format(time.DateTime))), 0)
+				client.Close("banned")
+			})
+		}
+	}
+	return nil
+}
+
+func (h *Hub) findOnlinePlayer(playerDbId int64) (uint64, *objects.Player, bool) {
+	var foundId uint64
+	var found *objects.Player
+	h.OnlinePlayers.ForEach(func(clientId uint64, player *objects.Player) {
+		if player.DbId == playerDbId {
+			foundId = clientId
+			found = player
+		}
+	})
+	return foundId, found, found != nil
+}
+
+func queryInt(r *http.Request, key string, fallback int64) (int64, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return fallback, nil
+	}
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("invalid %s", key)
+	}
+	return number, nil
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"server/internal/server/db"
+	"strings"
+)
+
+// Returned for any login which doesn't check out, so clients can't tell which part was wrong
+var ErrInvalidCredentials = errors.New("incorrect username or password")
+
+// Who a login belongs to
+type Identity struct {
+	// The lower cased username the user registered here with, for users which were
+	Username string
+
+	// Who the user is to the authenticator which vouched for them, for users which weren't registered here. Their
+	// names can change or be claimed by someone else, so this is what they're saved under.
+	ExternalID string
+
+	// The name to give the player the first time they log in, for users which weren't registered here
+	Name string
+}
+
+// Whether the identity belongs to the saved user
+func (i Identity) Matches(user db.User) bool {
+	if i.ExternalID != "" {
+		return user.ExternalID.Valid && user.ExternalID.String == i.ExternalID
+	}
+	return i.Username != "" && i.Username == user.Username
+}
+
+// Checks the credentials players log in with. The password is whatever secret the backend expects, e.g. a token.
+type Authenticator interface {
+	Authenticate(ctx context.Context, username string, password string) (Identity, error)
+
+	// Whether players can register new accounts on the server to log in with
+	AllowsRegistration() bool
+}
+
+// Checks passwords against the hashes of the users registered in the database. Hashes made with an older algorithm
+// or weaker parameters than the hasher's are replaced when their user logs in.
+type DatabaseAuthenticator struct {
+	queries *db.Queries
+	hasher  *Hasher
+}
+
+func NewDatabaseAuthenticator(queries *db.Queries, hasher *Hasher) *DatabaseAuthenticator {
+	return &DatabaseAuthenticator{queries: queries, hasher: hasher}
+}
+
+func (a *DatabaseAuthenticator) Authenticate(ctx context.Context, username string, password string) (Identity, error) {
+	username = strings.ToLower(username)
+	user, err := a.queries.GetUserByUsername(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Identity{}, ErrInvalidCredentials
+	} else if err != nil {
+		return Identity{}, err
+	}
+	if match, err := a.hasher.Verify(user.PasswordHash, password); !match {
+		if err != nil {
+			return Identity{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+		}
+		return Identity{}, ErrInvalidCredentials
+	}
+
+	if a.hasher.NeedsRehash(user.PasswordHash) {
+		a.rehash(ctx, user, password)
+	}
+	return Identity{Username: user.Username}, nil
+}
+
+func (a *DatabaseAuthenticator) AllowsRegistration() bool {
+	return true
+}
+
+// The login goes ahead whether or not this works, since the old hash is still good
+func (a *DatabaseAuthenticator) rehash(ctx context.Context, user db.User, password string) {
+	hash, err := a.hasher.Hash(password)
+	if err == nil {
+		err = a.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{PasswordHash: hash, ID: user.ID})
+	}
+	if err != nil {
+		log.Printf("Error rehashing the password of user %s: %v", user.Username, err)
+		return
+	}
+	log.Printf("Rehashed the password of user %s", user.Username)
+}
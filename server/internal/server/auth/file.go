@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const fileExternalIDPrefix = "file:"
+
+// Logs in the test accounts listed in a file, for running the server locally without registering anyone. Each line
+// is a username and its password separated by a colon, and lines starting with # are ignored.
+type FileAuthenticator struct {
+	passwords map[string]string
+	names     map[string]string
+}
+
+func NewFileAuthenticator(path string) (*FileAuthenticator, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	a := &FileAuthenticator{
+		passwords: make(map[string]string),
+		names:     make(map[string]string),
+	}
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, password, found := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("line %d of %s isn't a username:password pair", lineNumber, path)
+		}
+		username := strings.ToLower(name)
+		a.passwords[username] = password
+		a.names[username] = name
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Accounts are saved under their external ID, which is what their password is checked against once they're logged in
+func (a *FileAuthenticator) Authenticate(_ context.Context, username string, password string) (Identity, error) {
+	username = strings.TrimPrefix(strings.ToLower(username), fileExternalIDPrefix)
+	expected, exists := a.passwords[username]
+	if !exists || subtle.ConstantTimeCompare([]byte(expected), []byte(password)) != 1 {
+		return Identity{}, ErrInvalidCredentials
+	}
+	return Identity{ExternalID: fileExternalIDPrefix + username, Name: a.names[username]}, nil
+}
+
+// The file is the only place accounts come from
+func (a *FileAuthenticator) AllowsRegistration() bool {
+	return false
+}
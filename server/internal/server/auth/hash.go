@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// How new password hashes are made
+type HashAlgorithm int
+
+const (
+	HashBcrypt HashAlgorithm = iota
+	HashArgon2id
+)
+
+func ParseHashAlgorithm(algorithm string) (HashAlgorithm, error) {
+	switch strings.ToLower(algorithm) {
+	case "bcrypt":
+		return HashBcrypt, nil
+	case "argon2id":
+		return HashArgon2id, nil
+	}
+	return HashBcrypt, fmt.Errorf("unknown password hash algorithm %q", algorithm)
+}
+
+func (a HashAlgorithm) String() string {
+	if a == HashArgon2id {
+		return "argon2id"
+	}
+	return "bcrypt"
+}
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// Hashes passwords with the configured algorithm and cost. Every hash starts with its algorithm and parameters, in the
+// usual $<algorithm>$<parameters>$ format, so hashes made before the settings changed can still be checked.
+type Hasher struct {
+	Algorithm HashAlgorithm
+
+	BcryptCost int
+
+	// Passes over the memory, KiB of memory and threads used for each argon2id hash
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+// Defaults to bcrypt, with the argon2id parameters OWASP recommends for when it's switched to
+func NewHasher() *Hasher {
+	return &Hasher{
+		Algorithm:     HashBcrypt,
+		BcryptCost:    bcrypt.DefaultCost,
+		Argon2Time:    2,
+		Argon2Memory:  19 * 1024,
+		Argon2Threads: 1,
+	}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	switch h.Algorithm {
+	case HashArgon2id:
+		salt := make([]byte, argon2SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := argon2.IDKey([]byte(password), salt, h.Argon2Time, h.Argon2Memory, h.Argon2Threads, argon2KeyLength)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version,
+			h.Argon2Memory,
+			h.Argon2Time,
+			h.Argon2Threads,
+			base64.RawStdEncoding.EncodeToString(salt),
+			base64.RawStdEncoding.EncodeToString(key),
+		), nil
+	default:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.BcryptCost)
+		return string(hash), err
+	}
+}
+
+// Reports whether the password is the one the hash was made from, whichever algorithm made it
+func (h *Hasher) Verify(hash string, password string) (bool, error) {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		params, salt, key, err := parseArgon2Hash(hash)
+		if err != nil {
+			return false, err
+		}
+		candidate := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+		return subtle.ConstantTimeCompare(key, candidate) == 1, nil
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Reports whether the hash was made with another algorithm or weaker parameters than we'd use now, so it should be
+// replaced the next time we have the password
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		if h.Algorithm != HashArgon2id {
+			return true
+		}
+		params, _, _, err := parseArgon2Hash(hash)
+		return err != nil || params.time < h.Argon2Time || params.memory < h.Argon2Memory
+	}
+
+	if h.Algorithm != HashBcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < h.BcryptCost
+}
+
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+func parseArgon2Hash(hash string) (argon2Params, []byte, []byte, error) {
+	// The leading $ leaves an empty first part
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return argon2Params{}, nil, nil, errors.New("malformed argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2Params{}, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	var params argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return argon2Params{}, nil, nil, fmt.Errorf("malformed argon2id parameters: %w", err)
+	}
+	if params.time == 0 || params.threads == 0 {
+		return argon2Params{}, nil, nil, errors.New("argon2id parameters out of range")
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2Params{}, nil, nil, fmt.Errorf("malformed argon2id salt: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return argon2Params{}, nil, nil, fmt.Errorf("malformed argon2id hash: %w", err)
+	}
+	return params, salt, key, nil
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Hashers cheap enough to run in tests, one for each algorithm
+func testHashers() map[string]*Hasher {
+	bcryptHasher := NewHasher()
+	bcryptHasher.BcryptCost = bcrypt.MinCost
+
+	argon2Hasher := NewHasher()
+	argon2Hasher.Algorithm = HashArgon2id
+	argon2Hasher.Argon2Time = 1
+	argon2Hasher.Argon2Memory = 1024
+
+	return map[string]*Hasher{"bcrypt": bcryptHasher, "argon2id": argon2Hasher}
+}
+
+func TestHashRoundTrip(t *testing.T) {
+	for name, hasher := range testHashers() {
+		t.Run(name, func(t *testing.T) {
+			hash, err := hasher.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(hash, "$") {
+				t.Errorf("Hash() = %q, want it to start with its algorithm", hash)
+			}
+
+			if match, err := hasher.Verify(hash, "correct horse"); !match || err != nil {
+				t.Errorf("Verify() with the right password = %v, %v, want true", match, err)
+			}
+			if match, err := hasher.Verify(hash, "wrong horse"); match || err != nil {
+				t.Errorf("Verify() with the wrong password = %v, %v, want false", match, err)
+			}
+			if hasher.NeedsRehash(hash) {
+				t.Error("NeedsRehash() = true for a hash made with the current settings")
+			}
+
+			// Hashes made by the other algorithm can still be checked
+			for otherName, other := range testHashers() {
+				if match, err := other.Verify(hash, "correct horse"); !match || err != nil {
+					t.Errorf("Verify() by the %s hasher = %v, %v, want true", otherName, match, err)
+				}
+			}
+		})
+	}
+}
+
+func TestArgon2HashFormat(t *testing.T) {
+	hasher := testHashers()["argon2id"]
+	hash, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	params, salt, key, err := parseArgon2Hash(hash)
+	if err != nil {
+		t.Fatalf("parseArgon2Hash(%q) error = %v", hash, err)
+	}
+	if params.time != hasher.Argon2Time || params.memory != hasher.Argon2Memory || params.threads != hasher.Argon2Threads {
+		t.Errorf("parseArgon2Hash(%q) = %+v, want the hasher's parameters", hash, params)
+	}
+	if len(salt) != argon2SaltLength || len(key) != argon2KeyLength {
+		t.Errorf("parseArgon2Hash(%q) salt and key are %d and %d bytes, want %d and %d", hash, len(salt), len(key), argon2SaltLength, argon2KeyLength)
+	}
+
+	for _, malformed := range []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA",
+		"$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$not base64!$a2V5",
+	} {
+		if match, err := hasher.Verify(malformed, "correct horse"); match || err == nil {
+			t.Errorf("Verify(%q) = %v, %v, want an error", malformed, match, err)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	hashers := testHashers()
+	bcryptHash, err := hashers["bcrypt"].Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	argon2Hash, err := hashers["argon2id"].Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	strongerBcrypt := *hashers["bcrypt"]
+	strongerBcrypt.BcryptCost++
+	moreTime := *hashers["argon2id"]
+	moreTime.Argon2Time++
+	moreMemory := *hashers["argon2id"]
+	moreMemory.Argon2Memory *= 2
+	lessMemory := *hashers["argon2id"]
+	lessMemory.Argon2Memory /= 2
+
+	tests := []struct {
+		name   string
+		hasher *Hasher
+		hash   string
+		want   bool
+	}{
+		{"same bcrypt cost", hashers["bcrypt"], bcryptHash, false},
+		{"higher bcrypt cost", &strongerBcrypt, bcryptHash, true},
+		{"switched to argon2id", hashers["argon2id"], bcryptHash, true},
+		{"same argon2id parameters", hashers["argon2id"], argon2Hash, false},
+		{"more argon2id passes", &moreTime, argon2Hash, true},
+		{"more argon2id memory", &moreMemory, argon2Hash, true},
+		{"less argon2id memory", &lessMemory, argon2Hash, false},
+		{"switched to bcrypt", hashers["bcrypt"], argon2Hash, true},
+		{"malformed", hashers["bcrypt"], "not a hash", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.hasher.NeedsRehash(test.hash); got != test.want {
+				t.Errorf("NeedsRehash(%q) = %v, want %v", test.hash, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// How far the identity service's clock can be from ours before its tokens are refused
+const jwtClockSkew = time.Minute
+
+// Logs in players with the signed JSON web tokens an external identity service gives them, which they send in
+// place of a password. Tokens are checked against the service's public key, so the service is never contacted.
+type JWTAuthenticator struct {
+	publicKey crypto.PublicKey
+
+	// Only accept tokens from this issuer, if set
+	Issuer string
+
+	// Only accept tokens meant for this audience, if set
+	Audience string
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject           string          `json:"sub"`
+	PreferredUsername string          `json:"preferred_username"`
+	Issuer            string          `json:"iss"`
+	Audience          json.RawMessage `json:"aud"`
+	ExpiresAt         *int64          `json:"exp"`
+	NotBefore         *int64          `json:"nbf"`
+}
+
+// Reads the identity service's PEM encoded RSA, ECDSA (P-256) or Ed25519 public key from the file at the given path
+func NewJWTAuthenticator(publicKeyPath string) (*JWTAuthenticator, error) {
+	contents, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", publicKeyPath)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey, ed25519.PublicKey:
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported elliptic curve %s", key.Curve.Params().Name)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return &JWTAuthenticator{publicKey: publicKey}, nil
+}
+
+// The token says who the player is, so the username they sent is ignored. Only the issuer and subject are
+// guaranteed to stay the same and not be given to anyone else, so the preferred username is only used to name
+// the player.
+func (a *JWTAuthenticator) Authenticate(_ context.Context, _ string, token string) (Identity, error) {
+	claims, err := a.verify(token)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	name := claims.PreferredUsername
+	if name == "" {
+		name = claims.Subject
+	}
+	return Identity{ExternalID: "jwt:" + claims.Issuer + "|" + claims.Subject, Name: name}, nil
+}
+
+// Accounts are made with the identity service
+func (a *JWTAuthenticator) AllowsRegistration() bool {
+	return false
+}
+
+// Checks the token was signed by the identity service for us and is still valid, returning its claims
+func (a *JWTAuthenticator) verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return jwtClaims{}, fmt.Errorf("malformed header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, fmt.Errorf("malformed signature: %w", err)
+	}
+	if err := a.verifySignature(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return jwtClaims{}, err
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return jwtClaims{}, fmt.Errorf("malformed claims: %w", err)
+	}
+	now := time.Now()
+	if claims.ExpiresAt == nil {
+		return jwtClaims{}, errors.New("token doesn't expire")
+	}
+	if now.After(time.Unix(*claims.ExpiresAt, 0).Add(jwtClockSkew)) {
+		return jwtClaims{}, errors.New("token expired")
+	}
+	if claims.NotBefore != nil && now.Add(jwtClockSkew).Before(time.Unix(*claims.NotBefore, 0)) {
+		return jwtClaims{}, errors.New("token not valid yet")
+	}
+	if a.Issuer != "" && claims.Issuer != a.Issuer {
+		return jwtClaims{}, fmt.Errorf("token issued by %q", claims.Issuer)
+	}
+	if a.Audience != "" && !slices.Contains(claims.audiences(), a.Audience) {
+		return jwtClaims{}, errors.New("token meant for another audience")
+	}
+	if claims.Subject == "" {
+		return jwtClaims{}, errors.New("token has no subject")
+	}
+	return claims, nil
+}
+
+// Only the algorithm matching our key is accepted, so tokens can't pick a weaker one
+func (a *JWTAuthenticator) verifySignature(alg string, signed string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signed))
+	switch key := a.publicKey.(type) {
+	case *rsa.PublicKey:
+		if alg != "RS256" {
+			break
+		}
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if alg != "ES256" {
+			break
+		}
+		// The signature is r and s as 32 bytes each, one after the other
+		if len(signature) != 64 {
+			return errors.New("invalid signature")
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(key, digest[:], r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if alg != "EdDSA" {
+			break
+		}
+		if !ed25519.Verify(key, []byte(signed), signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unexpected signing algorithm %q", alg)
+}
+
+// The audience can be a single string or a list of them
+func (c jwtClaims) audiences() []string {
+	var audience string
+	if json.Unmarshal(c.Audience, &audience) == nil {
+		return []string{audience}
+	}
+	var audiences []string
+	json.Unmarshal(c.Audience, &audiences)
+	return audiences
+}
+
+func decodeJWTPart(part string, target any) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decoded, target)
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Signs the claims with the key, under whichever algorithm the header says
+func signJWT(t *testing.T, alg string, key crypto.Signer, claims map[string]any) string {
+	t.Helper()
+	encode := func(value any) string {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(encoded)
+	}
+	signed := encode(map[string]string{"alg": alg, "typ": "JWT"}) + "." + encode(claims)
+
+	var signature []byte
+	var err error
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, []byte(signed))
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestJWTAuthenticate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().Unix()
+	claims := func(changes map[string]any) map[string]any {
+		c := map[string]any{
+			"sub":                "user-1",
+			"preferred_username": "Alice",
+			"iss":                "https://id.example.com",
+			"aud":                "ringriot",
+			"exp":                now + 3600,
+		}
+		for claim, value := range changes {
+			if value == nil {
+				delete(c, claim)
+			} else {
+				c[claim] = value
+			}
+		}
+		return c
+	}
+
+	valid := signJWT(t, "RS256", rsaKey, claims(nil))
+	tests := []struct {
+		name   string
+		token  string
+		wantID string
+	}{
+		{"valid", valid, "jwt:https://id.example.com|user-1"},
+		{"audience list", signJWT(t, "RS256", rsaKey, claims(map[string]any{"aud": []string{"other", "ringriot"}})), "jwt:https://id.example.com|user-1"},
+		{"not before within skew", signJWT(t, "RS256", rsaKey, claims(map[string]any{"nbf": now + 30})), "jwt:https://id.example.com|user-1"},
+		{"expired within skew", signJWT(t, "RS256", rsaKey, claims(map[string]any{"exp": now - 30})), "jwt:https://id.example.com|user-1"},
+		{"same name, other subject", signJWT(t, "RS256", rsaKey, claims(map[string]any{"sub": "user-2"})), "jwt:https://id.example.com|user-2"},
+		{"wrong key", signJWT(t, "RS256", otherRSAKey, claims(nil)), ""},
+		{"wrong algorithm for the key", signJWT(t, "EdDSA", edKey, claims(nil)), ""},
+		{"no algorithm", signJWT(t, "none", rsaKey, claims(nil)), ""},
+		{"tampered signature", valid[:len(valid)-4] + "AAAA", ""},
+		{"expired", signJWT(t, "RS256", rsaKey, claims(map[string]any{"exp": now - 3600})), ""},
+		{"no expiry", signJWT(t, "RS256", rsaKey, claims(map[string]any{"exp": nil})), ""},
+		{"not valid yet", signJWT(t, "RS256", rsaKey, claims(map[string]any{"nbf": now + 3600})), ""},
+		{"wrong issuer", signJWT(t, "RS256", rsaKey, claims(map[string]any{"iss": "https://evil.example.com"})), ""},
+		{"wrong audience", signJWT(t, "RS256", rsaKey, claims(map[string]any{"aud": "other"})), ""},
+		{"wrong audience list", signJWT(t, "RS256", rsaKey, claims(map[string]any{"aud": []string{"other"}})), ""},
+		{"no subject", signJWT(t, "RS256", rsaKey, claims(map[string]any{"sub": nil})), ""},
+		{"malformed", "not.a-token", ""},
+	}
+
+	authenticator := &JWTAuthenticator{publicKey: &rsaKey.PublicKey, Issuer: "https://id.example.com", Audience: "ringriot"}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identity, err := authenticator.Authenticate(context.Background(), "", test.token)
+			if test.wantID == "" {
+				if !errors.Is(err, ErrInvalidCredentials) {
+					t.Errorf("Authenticate() = %+v, %v, want ErrInvalidCredentials", identity, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if identity.ExternalID != test.wantID || identity.Username != "" || identity.Name != "Alice" {
+				t.Errorf("Authenticate() = %+v, want external ID %q named Alice", identity, test.wantID)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticateEd25519(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := map[string]any{"sub": "user-1", "exp": time.Now().Unix() + 3600}
+
+	authenticator := &JWTAuthenticator{publicKey: publicKey}
+	identity, err := authenticator.Authenticate(context.Background(), "", signJWT(t, "EdDSA", privateKey, claims))
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	// Without a preferred username, the player is named after the subject
+	if identity.ExternalID != "jwt:|user-1" || identity.Name != "user-1" {
+		t.Errorf("Authenticate() = %+v, want external ID %q named user-1", identity, "jwt:|user-1")
+	}
+
+	if _, err := authenticator.Authenticate(context.Background(), "", signJWT(t, "RS256", rsaKey, claims)); err == nil {
+		t.Error("Authenticate() accepted an RS256 token with an Ed25519 key")
+	}
+}
@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcrypt ignores everything after this many bytes, so longer passwords would be misleading
+const maxPasswordBytes int = 72
+
+// Names staff and the server itself go by, which nobody can register or anything that looks like them
+var defaultReservedNames = []string{"admin", "administrator", "moderator", "mod", "server", "system", "staff", "support", "bot", "guest"}
+
+// Alphabets players can write their names in. Japanese is written with a mix of scripts, so those count as one.
+var nameScripts = [][]*unicode.RangeTable{
+	{unicode.Latin},
+	{unicode.Cyrillic},
+	{unicode.Greek},
+	{unicode.Arabic},
+	{unicode.Hebrew},
+	{unicode.Thai},
+	{unicode.Hangul},
+	{unicode.Han, unicode.Hiragana, unicode.Katakana},
+}
+
+// Characters which look the same as, or close enough to, other ones. Names are compared after swapping these for
+// what they look like, so nobody can pass themselves off as someone else.
+var confusables = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p', 'с': 'c', 'т': 't',
+	'у': 'y', 'х': 'x', 'і': 'l', 'ї': 'l', 'ј': 'j', 'ѕ': 's', 'ԁ': 'd', 'һ': 'h', 'ԛ': 'q', 'ԝ': 'w',
+	// Greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'l', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't', 'υ': 'u',
+	'χ': 'x', 'ω': 'w',
+	// Accented Latin
+	'à': 'a', 'á': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a', 'ç': 'c', 'è': 'e', 'é': 'e', 'ê': 'e', 'ë': 'e',
+	'ì': 'l', 'í': 'l', 'î': 'l', 'ï': 'l', 'ñ': 'n', 'ò': 'o', 'ó': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o', 'ø': 'o',
+	'ù': 'u', 'ú': 'u', 'û': 'u', 'ü': 'u', 'ý': 'y', 'ÿ': 'y', 'ı': 'l',
+	// Letters and digits which are hard to tell apart in most fonts
+	'i': 'l', '1': 'l', '|': 'l', '0': 'o', '5': 's', '$': 's',
+}
+
+// Letter pairs which look like a single letter
+var confusablePairs = strings.NewReplacer("rn", "m", "vv", "w")
+
+// Rules for the usernames and passwords players can register with
+type Policy struct {
+	// Can be changed while players are registering, see SetMinPasswordLength
+	minPasswordLength atomic.Int64
+
+	MinUsernameLength int
+	MaxUsernameLength int
+
+	// The name keys of the reserved names
+	reserved map[string]struct{}
+
+	// Lower cased passwords which are too well known to be safe
+	commonPasswords map[string]struct{}
+}
+
+func NewPolicy() *Policy {
+	p := &Policy{
+		MinUsernameLength: 3,
+		MaxUsernameLength: 20,
+		reserved:          make(map[string]struct{}),
+		commonPasswords:   make(map[string]struct{}),
+	}
+	p.SetMinPasswordLength(8)
+	p.Reserve(defaultReservedNames...)
+	return p
+}
+
+func (p *Policy) MinPasswordLength() int {
+	return int(p.minPasswordLength.Load())
+}
+
+// Changes how short passwords can be from then on. It only applies to new passwords, so nobody's locked out.
+func (p *Policy) SetMinPasswordLength(length int) {
+	p.minPasswordLength.Store(int64(length))
+}
+
+// Stops the names, and anything which looks like them, from being registered
+func (p *Policy) Reserve(names ...string) {
+	for _, name := range names {
+		if key := NameKey(strings.TrimSpace(name)); key != "" {
+			p.reserved[key] = struct{}{}
+		}
+	}
+}
+
+// Reads the passwords which are too common to use from the file at the given path, one per line
+func (p *Policy) LoadCommonPasswords(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	passwords := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			passwords[strings.ToLower(password)] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	p.commonPasswords = passwords
+	log.Printf("Loaded %d common passwords from %s", len(passwords), path)
+	return nil
+}
+
+// Checks the name against each of the rules in turn, returning why it broke the first one it did
+func (p *Policy) CheckUsername(name string) error {
+	length := utf8.RuneCountInString(name)
+	if length == 0 {
+		return errors.New("empty")
+	}
+	if length < p.MinUsernameLength {
+		return fmt.Errorf("must be at least %d characters long", p.MinUsernameLength)
+	}
+	if length > p.MaxUsernameLength {
+		return fmt.Errorf("must be at most %d characters long", p.MaxUsernameLength)
+	}
+
+	script := -1
+	for i, r := range name {
+		if i == 0 && !unicode.IsLetter(r) {
+			return errors.New("must start with a letter")
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			return errors.New("can only contain letters, numbers, underscores and hyphens")
+		}
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if letterScript := scriptOf(r); letterScript == -1 {
+			return fmt.Errorf("can't contain the letter %q", r)
+		} else if script != -1 && letterScript != script {
+			return errors.New("can't mix letters from different alphabets")
+		} else {
+			script = letterScript
+		}
+	}
+
+	// Numbers on the end don't make a reserved name any less misleading
+	trimmed := strings.TrimRightFunc(name, func(r rune) bool { return unicode.IsDigit(r) || r == '_' || r == '-' })
+	if _, reserved := p.reserved[NameKey(trimmed)]; reserved {
+		return errors.New("reserved")
+	}
+	return nil
+}
+
+// Checks the password against each of the rules in turn, returning why it broke the first one it did
+func (p *Policy) CheckPassword(password string, username string) error {
+	if password == "" {
+		return errors.New("empty")
+	}
+	if minLength := p.MinPasswordLength(); utf8.RuneCountInString(password) < minLength {
+		return fmt.Errorf("must be at least %d characters long", minLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return fmt.Errorf("must be at most %d bytes long", maxPasswordBytes)
+	}
+	if strings.EqualFold(password, username) {
+		return errors.New("can't be the same as your username")
+	}
+	if _, common := p.commonPasswords[strings.ToLower(password)]; common {
+		return errors.New("too common, please pick a less guessable one")
+	}
+	return nil
+}
+
+// What the name looks like, ignoring case, punctuation and characters which look alike. Names with the same key
+// are too easily mistaken for each other, so only one player can have each.
+func NameKey(name string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(name) {
+		// Full width forms of ASCII characters
+		if r >= '！' && r <= '～' {
+			r = unicode.ToLower(r - '！' + '!')
+		}
+		if replacement, confusable := confusables[r]; confusable {
+			r = replacement
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return confusablePairs.Replace(builder.String())
+}
+
+func scriptOf(r rune) int {
+	for i, tables := range nameScripts {
+		if unicode.IsOneOf(tables, r) {
+			return i
+		}
+	}
+	return -1
+}
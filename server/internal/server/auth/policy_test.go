@@ -0,0 +1,94 @@
+package auth
+
+import "testing"
+
+func TestNameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		same bool
+	}{
+		{"case", "Alice", "aLICE", true},
+		{"punctuation", "bob_the-builder", "bobthebuilder", true},
+		{"digits as letters", "B0b1", "bobl", true},
+		{"i and l", "Illia", "llLla", true},
+		{"letter pairs", "rnarvin", "marvin", true},
+		{"cyrillic lookalikes", "аlice", "alice", true},
+		{"greek lookalikes", "ροτατο", "potato", true},
+		{"accents", "Zoë", "zoe", true},
+		{"full width", "Ｂｏｂ", "bob", true},
+		{"different names", "alice", "alicia", false},
+		{"different digits", "bob2", "bob3", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := NameKey(test.a), NameKey(test.b)
+			if (a == b) != test.same {
+				t.Errorf("NameKey(%q) = %q, NameKey(%q) = %q, want same = %v", test.a, a, test.b, b, test.same)
+			}
+		})
+	}
+}
+
+func TestCheckUsername(t *testing.T) {
+	policy := NewPolicy()
+	policy.Reserve("Alice")
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"bob", false},
+		{"bob_42", false},
+		{"Борис", false},
+		{"", true},
+		{"bo", true},
+		{"abcdefghijklmnopqrstu", true},
+		{"1bob", true},
+		{"bob!", true},
+		{"bоb", true},
+		{"admin", true},
+		{"ADMIN", true},
+		{"Adm1n", true},
+		{"admin123", true},
+		{"mod_", true},
+		{"Guest0042", true},
+		{"alice", true},
+		{"Al1ce", true},
+		{"administrators", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := policy.CheckUsername(test.name)
+			if (err != nil) != test.wantErr {
+				t.Errorf("CheckUsername(%q) = %v, want error = %v", test.name, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckPassword(t *testing.T) {
+	policy := NewPolicy()
+	policy.commonPasswords["password123"] = struct{}{}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{"long enough", "correct horse", false},
+		{"empty", "", true},
+		{"too short", "short", true},
+		{"too long for bcrypt", string(make([]byte, maxPasswordBytes+1)), true},
+		{"same as username", "BobBobBob", true},
+		{"common", "Password123", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := policy.CheckPassword(test.password, "bobbobbob")
+			if (err != nil) != test.wantErr {
+				t.Errorf("CheckPassword(%q) = %v, want error = %v", test.password, err, test.wantErr)
+			}
+		})
+	}
+}
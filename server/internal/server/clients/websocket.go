@@ -3,10 +3,16 @@ package clients
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"server/internal/server"
+	"server/internal/server/auth"
+	"server/internal/server/objects"
+	"server/internal/server/profanity"
 	"server/internal/server/states"
 	"server/pkg/packets"
+	"strings"
+	"sync"
 
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/proto"
@@ -17,16 +23,97 @@ type WebSocketClient struct {
 	conn     *websocket.Conn
 	hub      *server.Hub
 	sendChan chan *packets.Packet
-	state    server.ClientStateHandler
 	logger   *log.Logger
 	dbTx     *server.DbTx
+	address  string
+
+	// Only changed on the client's own goroutine, which can read it freely. Other goroutines can see which state the
+	// client is in through State, but mustn't touch what's in it.
+	state    server.ClientStateHandler
+	stateMux sync.Mutex
+
+	// Packets read from our own socket, waiting to be handled. The read pump waits for room here, so a client
+	// sending too fast only slows itself down.
+	received chan *packets.Packet
+
+	// Messages from other clients and the hub, and work handed back from other goroutines, waiting to run on the
+	// client's own goroutine. Nobody waits for room here, since they could be waiting on us.
+	jobs chan func()
+
+	// Closed to ask the client's goroutine to close the client, and once it has, to stop the pumps
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	reason    string
 }
 
-func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
-	upgrader := websocket.Upgrader{
+// How many jobs can wait for a client before new ones are dropped
+const jobQueueSize int = 1024
+
+// Settings for the WebSocket connections clients make
+type WebSocketConfig struct {
+	ReadBufferSize  int
+	WriteBufferSize int
+
+	// How many packets can wait to be sent to a client before new ones are dropped
+	SendQueueSize int
+
+	// The origins browsers can connect from, like https://example.com, or * for any. Connections without an
+	// origin, which is everything except browsers, are always allowed.
+	AllowedOrigins []string
+
+	// The header a reverse proxy puts the client's address in, like X-Forwarded-For. Without one, clients are known
+	// by the address they connected from, which behind a proxy is the proxy's.
+	RealIPHeader string
+}
+
+func DefaultWebSocketConfig() *WebSocketConfig {
+	return &WebSocketConfig{
 		ReadBufferSize:  1024,
 		WriteBufferSize: 1024,
-		CheckOrigin:     func(_ *http.Request) bool { return true }, // here we are considering all clients to access we can limit clients with custom logic
+		SendQueueSize:   256,
+		AllowedOrigins:  []string{"*"},
+	}
+}
+
+func (cfg *WebSocketConfig) checkOrigin(request *http.Request) bool {
+	origin := request.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range cfg.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	log.Printf("Refused a connection from %s with origin %s", request.RemoteAddr, origin)
+	return false
+}
+
+// The address the client is connecting from. A proxy adds the address it saw to the end of the header, so that's
+// the one to trust, since anything before it could have come from the client.
+func (cfg *WebSocketConfig) clientAddress(request *http.Request) string {
+	if cfg.RealIPHeader != "" {
+		values := request.Header.Values(cfg.RealIPHeader)
+		if len(values) > 0 {
+			entries := strings.Split(values[len(values)-1], ",")
+			if address := strings.TrimSpace(entries[len(entries)-1]); address != "" {
+				return address
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
+
+func (cfg *WebSocketConfig) NewClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  cfg.ReadBufferSize,
+		WriteBufferSize: cfg.WriteBufferSize,
+		CheckOrigin:     cfg.checkOrigin,
 	}
 	conn, err := upgrader.Upgrade(writer, request, nil)
 	if err != nil {
@@ -35,9 +122,14 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 	c := &WebSocketClient{
 		hub:      hub,
 		conn:     conn,
-		sendChan: make(chan *packets.Packet, 256),
-		logger:   log.New(log.Writer(), "Client Unknown: ", log.LstdFlags),
+		sendChan: make(chan *packets.Packet, cfg.SendQueueSize),
+		logger:   server.NewClientLogger("Client Unknown: "),
 		dbTx:     hub.NewDbTx(),
+		address:  cfg.clientAddress(request),
+		received: make(chan *packets.Packet, 16),
+		jobs:     make(chan func(), jobQueueSize),
+		closing:  make(chan struct{}),
+		done:     make(chan struct{}),
 	}
 	return c, nil
 }
@@ -46,6 +138,10 @@ func (c *WebSocketClient) Id() uint64 {
 	return c.id
 }
 
+func (c *WebSocketClient) Address() string {
+	return c.address
+}
+
 func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 	prevStateName := "None"
 	if c.state != nil {
@@ -58,21 +154,62 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 		newStateName = state.Name()
 	}
 	c.logger.Printf("Switching from %s to %s", prevStateName, newStateName)
+	c.stateMux.Lock()
 	c.state = state
+	c.stateMux.Unlock()
 
 	if c.state != nil {
 		c.state.SetClient(c)
 		c.state.OnEnter()
 	}
 }
+func (c *WebSocketClient) State() server.ClientStateHandler {
+	c.stateMux.Lock()
+	defer c.stateMux.Unlock()
+	return c.state
+}
+
 func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
-	c.state.HandlerMessage(senderId, message)
+	c.Enqueue(func() { c.handleMessage(senderId, message) })
+}
+
+func (c *WebSocketClient) handleMessage(senderId uint64, message packets.Msg) {
+	if c.state != nil {
+		c.state.HandlerMessage(senderId, message)
+	}
+}
+
+func (c *WebSocketClient) Enqueue(job func()) {
+	select {
+	case <-c.done:
+	case c.jobs <- job:
+	default:
+		c.logger.Println("Job queue full, dropping job")
+	}
 }
 
 func (c *WebSocketClient) Initialize(id uint64) {
 	c.id = id
 	c.logger.SetPrefix(fmt.Sprintf("Client %d: ", c.id))
+	// Nothing else can be touching the client before it starts running, and setting the state first means its
+	// first packets can't be handled without one
 	c.SetState(&states.Connected{})
+	go c.run()
+}
+
+// Handles the client's packets and jobs one at a time until the client is closed
+func (c *WebSocketClient) run() {
+	for {
+		select {
+		case packet := <-c.received:
+			c.handleMessage(packet.SenderId, packet.Msg)
+		case job := <-c.jobs:
+			job()
+		case <-c.closing:
+			c.close()
+			return
+		}
+	}
 }
 
 func (c *WebSocketClient) SocketSend(message packets.Msg) {
@@ -81,6 +218,7 @@ func (c *WebSocketClient) SocketSend(message packets.Msg) {
 
 func (c *WebSocketClient) SocketSendAs(message packets.Msg, senderid uint64) {
 	select {
+	case <-c.done:
 	case c.sendChan <- &packets.Packet{SenderId: senderid, Msg: message}:
 	default:
 		c.logger.Printf("Send channel full, dropping message: %T", message)
@@ -120,40 +258,67 @@ func (c *WebSocketClient) ReadPump() {
 		if packet.SenderId == 0 {
 			packet.SenderId = c.id
 		}
-		c.ProcessMessage(packet.SenderId, packet.Msg)
+		select {
+		case c.received <- packet:
+		case <-c.done:
+			return
+		}
 	}
 }
 
 func (c *WebSocketClient) WritePump() {
 	defer func() {
 		c.logger.Println("Closing write pump")
+		c.conn.Close()
 		c.Close("write pump closed")
 	}()
 
-	for packet := range c.sendChan {
-		writer, err := c.conn.NextWriter(websocket.BinaryMessage)
-		if err != nil {
-			c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
-			return
-		}
-		data, err := proto.Marshal(packet)
-		if err != nil {
-			c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
-			continue
-		}
-		_, err = writer.Write(data)
-		if err != nil {
-			c.logger.Printf("error writing %T packet: %v", packet.Msg, err)
-			continue
-		}
-		writer.Write([]byte{'\n'})
-		if err = writer.Close(); err != nil {
-			c.logger.Printf("error closing writer for %T packet: %v", packet.Msg, err)
-			continue
+	for {
+		select {
+		case packet := <-c.sendChan:
+			if !c.writePacket(packet) {
+				return
+			}
+		case <-c.done:
+			// Send what's left first, so things like the reason for a kick still reach the client
+			for {
+				select {
+				case packet := <-c.sendChan:
+					if !c.writePacket(packet) {
+						return
+					}
+				default:
+					return
+				}
+			}
 		}
 	}
 }
 
+// Writes the packet to the socket, returning false if the connection is broken
+func (c *WebSocketClient) writePacket(packet *packets.Packet) bool {
+	writer, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
+		return false
+	}
+	data, err := proto.Marshal(packet)
+	if err != nil {
+		c.logger.Printf("error getting writer for %T packet, closing client: %v", packet.Msg, err)
+		return true
+	}
+	_, err = writer.Write(data)
+	if err != nil {
+		c.logger.Printf("error writing %T packet: %v", packet.Msg, err)
+		return true
+	}
+	writer.Write([]byte{'\n'})
+	if err = writer.Close(); err != nil {
+		c.logger.Printf("error closing writer for %T packet: %v", packet.Msg, err)
+	}
+	return true
+}
+
 func (c *WebSocketClient) DbTx() *server.DbTx {
 	return c.dbTx
 }
@@ -162,13 +327,55 @@ func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
 	return c.hub.SharedGameObjects
 }
 
+func (c *WebSocketClient) Rules() *server.Rules {
+	return c.hub.Rules()
+}
+
+func (c *WebSocketClient) Round() *server.Round {
+	return c.hub.Round
+}
+
+func (c *WebSocketClient) OnlinePlayers() *objects.SharedCollection[*objects.Player] {
+	return c.hub.OnlinePlayers
+}
+
+func (c *WebSocketClient) Clients() *objects.SharedCollection[server.ClientInterfacer] {
+	return c.hub.Clients
+}
+
+func (c *WebSocketClient) ChatFilter() *profanity.Filter {
+	return c.hub.ChatFilter
+}
+
+func (c *WebSocketClient) AccountPolicy() *auth.Policy {
+	return c.hub.AccountPolicy
+}
+
+func (c *WebSocketClient) Authenticator() auth.Authenticator {
+	return c.hub.Authenticator
+}
+
+func (c *WebSocketClient) PasswordHasher() *auth.Hasher {
+	return c.hub.PasswordHasher
+}
+
+func (c *WebSocketClient) LoginWorkers() *server.WorkerPool {
+	return c.hub.LoginWorkers
+}
+
 func (c *WebSocketClient) Close(reason string) {
-	c.logger.Printf("Closing client connection because: %s", reason)
-	c.Broadcast(packets.NewDisconnect(reason))
+	c.closeOnce.Do(func() {
+		c.reason = reason
+		close(c.closing)
+	})
+}
+
+// Runs on the client's own goroutine, so the state is exited there like any other change of state. The write pump
+// closes the connection once it's sent what was already queued.
+func (c *WebSocketClient) close() {
+	c.logger.Printf("Closing client connection because: %s", c.reason)
+	c.Broadcast(packets.NewDisconnect(c.reason))
 	c.SetState(nil)
 	c.hub.UnregisterChan <- c
-	c.conn.Close()
-	if _, closed := <-c.sendChan; !closed {
-		close(c.sendChan)
-	}
+	close(c.done)
 }
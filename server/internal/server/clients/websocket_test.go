@@ -0,0 +1,115 @@
+package clients
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"server/internal/server"
+	"server/pkg/packets"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// A state which records the messages it's given, and notices if it's ever given two at once
+type recordingState struct {
+	t       *testing.T
+	running atomic.Int32
+	handled map[uint64][]string
+	want    int
+	count   int
+	allDone chan struct{}
+}
+
+func (s *recordingState) Name() string                             { return "Recording" }
+func (s *recordingState) SetClient(client server.ClientInterfacer) {}
+func (s *recordingState) OnEnter()                                 {}
+func (s *recordingState) OnExit()                                  {}
+
+func (s *recordingState) HandlerMessage(senderId uint64, message packets.Msg) {
+	if s.running.Add(1) > 1 {
+		s.t.Error("two messages were handled at once")
+	}
+	defer s.running.Add(-1)
+
+	s.handled[senderId] = append(s.handled[senderId], message.(*packets.Packet_Chat).Chat.Msg)
+	s.count++
+	if s.count == s.want {
+		close(s.allDone)
+	}
+}
+
+// A client which isn't connected to anything, for testing how it runs what it's given
+func newTestClient(state server.ClientStateHandler) *WebSocketClient {
+	return &WebSocketClient{
+		logger:   log.New(io.Discard, "", 0),
+		state:    state,
+		received: make(chan *packets.Packet, 16),
+		jobs:     make(chan func(), jobQueueSize),
+		closing:  make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func TestClientHandlesOneThingAtATimeInOrder(t *testing.T) {
+	const senders, perSender = 4, 200
+	state := &recordingState{t: t, handled: make(map[uint64][]string), want: (senders + 1) * perSender, allDone: make(chan struct{})}
+	c := newTestClient(state)
+	go c.run()
+
+	var wg sync.WaitGroup
+	// Other clients passing messages on, each from its own goroutine
+	for sender := uint64(1); sender <= senders; sender++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perSender {
+				c.ProcessMessage(sender, packets.NewChat(fmt.Sprint(i)))
+			}
+		}()
+	}
+	// The read pump handing over the client's own packets
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range perSender {
+			c.received <- &packets.Packet{SenderId: 0, Msg: packets.NewChat(fmt.Sprint(i))}
+		}
+	}()
+	wg.Wait()
+
+	select {
+	case <-state.allDone:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("only %d of %d messages were handled", state.count, state.want)
+	}
+
+	// Messages from the same sender are handled in the order they were sent
+	for sender, messages := range state.handled {
+		for i, message := range messages {
+			if message != fmt.Sprint(i) {
+				t.Fatalf("message %d from sender %d was %q, want %q", i, sender, message, fmt.Sprint(i))
+			}
+		}
+	}
+}
+
+func TestEnqueueDoesntWaitForAClosedClient(t *testing.T) {
+	c := newTestClient(nil)
+	close(c.done)
+
+	finished := make(chan struct{})
+	go func() {
+		// More than the queue can hold, and nothing's running them
+		for range jobQueueSize + 1 {
+			c.Enqueue(func() {})
+		}
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Enqueue blocked on a closed client")
+	}
+}
@@ -10,14 +10,52 @@ INSERT INTO users (
 )
 RETURNING *;
 
+-- name: CreateExternalUser :one
+INSERT INTO users (
+    username, password_hash, external_id
+) VALUES (
+    ?, '', ?
+)
+RETURNING *;
+
+-- name: GetUserByExternalID :one
+SELECT * FROM users
+WHERE external_id = ? LIMIT 1;
+
 -- name: CreatePlayer :one
 INSERT INTO players (
-    user_id, name,color
+    user_id, name,color, name_key
 ) VALUES (
-    ?, ?, ?
+    ?, ?, ?, ?
 )
 RETURNING *;
 
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = ? LIMIT 1;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = ?
+WHERE id = ?;
+
+-- name: UpdateUserAddress :exec
+UPDATE users
+SET last_address = ?
+WHERE id = ?;
+
+-- name: ListUserRestrictionsByAddress :many
+SELECT muted_until, banned_until FROM users
+WHERE last_address = ? AND (muted_until IS NOT NULL OR banned_until IS NOT NULL);
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?;
+
+-- name: GetPlayerByID :one
+SELECT * FROM players
+WHERE id = ? LIMIT 1;
+
 -- name: GetPlayerByUserID :one
 SELECT * FROM players
 WHERE user_id = ? LIMIT 1;
@@ -27,6 +65,34 @@ UPDATE players
 SET best_score = ?
 WHERE id = ?;
 
+-- name: UpdatePlayerName :exec
+UPDATE players
+SET name = ?, name_key = ?, name_changed_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: GetPlayerByNameKey :one
+SELECT * FROM players
+WHERE name_key = ?
+LIMIT 1;
+
+-- name: ListPlayersWithoutNameKey :many
+SELECT id, name FROM players
+WHERE name_key = '';
+
+-- name: UpdatePlayerNameKey :exec
+UPDATE players
+SET name_key = ?
+WHERE id = ?;
+
+-- name: UpdatePlayerColor :exec
+UPDATE players
+SET color = ?
+WHERE id = ?;
+
+-- name: DeletePlayer :exec
+DELETE FROM players
+WHERE id = ?;
+
 -- name: GetTopScores :many
 SELECT name, best_score
 FROM players
@@ -44,4 +110,164 @@ SELECT COUNT(*) + 1 AS "rank" FROM players
 WHERE best_score >= (
     SELECT best_score FROM players p2
     WHERE p2.id = ?
-);
\ No newline at end of file
+);
+
+-- name: CreateRound :one
+INSERT INTO rounds (
+    started_at
+) VALUES (
+    CURRENT_TIMESTAMP
+)
+RETURNING *;
+
+-- name: FinishRound :exec
+UPDATE rounds
+SET ended_at = CURRENT_TIMESTAMP, winner_player_id = ?
+WHERE id = ?;
+
+-- name: CreateRoundResult :exec
+INSERT INTO round_results (
+    round_id, player_id, placement
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: MutePlayer :exec
+UPDATE users
+SET muted_until = ?
+WHERE id = (
+    SELECT user_id FROM players
+    WHERE players.id = ?
+);
+
+-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    player_id, channel, target, text, filtered
+) VALUES (
+    ?, ?, ?, ?, ?
+);
+
+-- name: GetRecentChatMessages :many
+SELECT chat_messages.*, players.name
+FROM chat_messages
+JOIN players ON players.id = chat_messages.player_id
+WHERE chat_messages.channel IN (?, ?)
+ORDER BY chat_messages.id DESC
+LIMIT ?;
+
+-- name: SearchPlayerChatMessages :many
+SELECT * FROM chat_messages
+WHERE player_id = ? AND text LIKE ?
+ORDER BY id DESC
+LIMIT ?;
+
+-- name: DeleteChatMessagesBefore :exec
+DELETE FROM chat_messages
+WHERE sent_at < ?;
+
+-- name: DeleteOldestChatMessages :exec
+DELETE FROM chat_messages
+WHERE id <= (
+    SELECT id FROM chat_messages
+    ORDER BY id DESC
+    LIMIT 1
+    OFFSET ?
+);
+
+-- name: BanPlayer :exec
+UPDATE users
+SET banned_until = ?
+WHERE id = (
+    SELECT user_id FROM players
+    WHERE players.id = ?
+);
+
+-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_player_id, target_player_id, reason, text, chat_snapshot, best_score, mass
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: CountOpenReports :one
+SELECT COUNT(*) FROM reports
+WHERE reporter_player_id = ? AND target_player_id = ? AND status = 'open';
+
+-- name: GetReport :one
+SELECT * FROM reports
+WHERE id = ? LIMIT 1;
+
+-- name: ListReports :many
+SELECT reports.*, target.name AS target_name, reporter.name AS reporter_name
+FROM reports
+JOIN players target ON target.id = reports.target_player_id
+LEFT JOIN players reporter ON reporter.id = reports.reporter_player_id
+WHERE reports.status = ?
+ORDER BY reports.id
+LIMIT ?
+OFFSET ?;
+
+-- name: ResolveReport :exec
+UPDATE reports
+SET status = 'resolved', resolution = ?, resolved_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: CreateFriendRequest :exec
+INSERT INTO friends (
+    player_id, friend_id
+) VALUES (
+    ?, ?
+);
+
+-- name: GetFriendship :one
+SELECT * FROM friends
+WHERE (player_id = sqlc.arg(player_id) AND friend_id = sqlc.arg(friend_id))
+    OR (player_id = sqlc.arg(friend_id) AND friend_id = sqlc.arg(player_id))
+LIMIT 1;
+
+-- name: AcceptFriendRequest :exec
+UPDATE friends
+SET status = 'accepted'
+WHERE player_id = ? AND friend_id = ?;
+
+-- name: DeleteFriendship :exec
+DELETE FROM friends
+WHERE (player_id = sqlc.arg(player_id) AND friend_id = sqlc.arg(friend_id))
+    OR (player_id = sqlc.arg(friend_id) AND friend_id = sqlc.arg(player_id));
+
+-- name: ListFriends :many
+SELECT players.id, players.name, friends.status, friends.player_id AS requester_id
+FROM friends
+JOIN players ON players.id = CASE
+    WHEN friends.player_id = sqlc.arg(player_id) THEN friends.friend_id
+    ELSE friends.player_id
+END
+WHERE friends.player_id = sqlc.arg(player_id) OR friends.friend_id = sqlc.arg(player_id)
+ORDER BY players.name;
+
+-- name: DeletePlayerFriends :exec
+DELETE FROM friends
+WHERE player_id = sqlc.arg(player_id) OR friend_id = sqlc.arg(player_id);
+
+-- name: DeletePlayerChatMessages :exec
+DELETE FROM chat_messages
+WHERE player_id = ?;
+
+-- name: DeletePlayerReports :exec
+DELETE FROM reports
+WHERE target_player_id = ?;
+
+-- name: ClearReportsByReporter :exec
+UPDATE reports
+SET reporter_player_id = NULL
+WHERE reporter_player_id = ?;
+
+-- name: DeletePlayerRoundResults :exec
+DELETE FROM round_results
+WHERE player_id = ?;
+
+-- name: ClearRoundWinner :exec
+UPDATE rounds
+SET winner_player_id = NULL
+WHERE winner_player_id = ?;
\ No newline at end of file
@@ -1,7 +1,14 @@
 CREATE TABLE IF NOT EXISTS users (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
     username TEXT NOT NULL UNIQUE,
-    password_hash TEXT NOT NULL
+    password_hash TEXT NOT NULL,
+    role TEXT NOT NULL DEFAULT 'player',
+    muted_until DATETIME,
+    banned_until DATETIME,
+    last_address TEXT NOT NULL DEFAULT '',
+    -- Set for users an external identity service vouches for, who don't have a password hash. Unique by the
+    -- users_external_id index the server creates, so old databases get it too.
+    external_id TEXT
 );
 
 CREATE TABLE IF NOT EXISTS players (
@@ -10,5 +17,65 @@ CREATE TABLE IF NOT EXISTS players (
     name TEXT NOT NULL UNIQUE,
     best_score INTEGER NOT NULL DEFAULT 0,
     color INTEGER NOT NULL,
+    name_changed_at DATETIME,
+    -- Unique once old databases have been migrated, by the players_name_key index the server creates then
+    name_key TEXT NOT NULL DEFAULT '',
     FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS rounds (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    started_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ended_at DATETIME,
+    winner_player_id INTEGER,
+    FOREIGN KEY (winner_player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS round_results (
+    round_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    placement INTEGER NOT NULL,
+    PRIMARY KEY (round_id, player_id),
+    FOREIGN KEY (round_id) REFERENCES rounds(id),
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS chat_messages (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    channel INTEGER NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    text TEXT NOT NULL,
+    filtered BOOLEAN NOT NULL DEFAULT FALSE,
+    sent_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE INDEX IF NOT EXISTS chat_messages_player_id ON chat_messages (player_id, sent_at);
+
+CREATE TABLE IF NOT EXISTS reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    reporter_player_id INTEGER,
+    target_player_id INTEGER NOT NULL,
+    reason INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    chat_snapshot TEXT NOT NULL,
+    best_score INTEGER NOT NULL,
+    mass REAL NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open',
+    resolution TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at DATETIME,
+    FOREIGN KEY (reporter_player_id) REFERENCES players(id),
+    FOREIGN KEY (target_player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS friends (
+    player_id INTEGER NOT NULL,
+    friend_id INTEGER NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, friend_id),
+    FOREIGN KEY (player_id) REFERENCES players(id),
+    FOREIGN KEY (friend_id) REFERENCES players(id)
 );
\ No newline at end of file
@@ -4,16 +4,73 @@
 
 package db
 
+import (
+	"database/sql"
+	"time"
+)
+
+type ChatMessage struct {
+	ID       int64
+	PlayerID int64
+	Channel  int64
+	Target   string
+	Text     string
+	Filtered bool
+	SentAt   time.Time
+}
+
+type Friend struct {
+	PlayerID  int64
+	FriendID  int64
+	Status    string
+	CreatedAt time.Time
+}
+
 type Player struct {
-	ID        int64
-	UserID    int64
-	Name      string
-	BestScore int64
-	Color     int64
+	ID            int64
+	UserID        int64
+	Name          string
+	BestScore     int64
+	Color         int64
+	NameChangedAt sql.NullTime
+	NameKey       string
+}
+
+type Report struct {
+	ID               int64
+	ReporterPlayerID sql.NullInt64
+	TargetPlayerID   int64
+	Reason           int64
+	Text             string
+	ChatSnapshot     string
+	BestScore        int64
+	Mass             float64
+	Status           string
+	Resolution       string
+	CreatedAt        time.Time
+	ResolvedAt       sql.NullTime
+}
+
+type Round struct {
+	ID             int64
+	StartedAt      time.Time
+	EndedAt        sql.NullTime
+	WinnerPlayerID sql.NullInt64
+}
+
+type RoundResult struct {
+	RoundID   int64
+	PlayerID  int64
+	Placement int64
 }
 
 type User struct {
 	ID           int64
 	Username     string
 	PasswordHash string
+	Role         string
+	MutedUntil   sql.NullTime
+	BannedUntil  sql.NullTime
+	LastAddress  string
+	ExternalID   sql.NullString
 }
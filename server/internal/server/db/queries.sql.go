@@ -7,25 +7,182 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const acceptFriendRequest = `-- name: AcceptFriendRequest :exec
+UPDATE friends
+SET status = 'accepted'
+WHERE player_id = ? AND friend_id = ?
+`
+
+type AcceptFriendRequestParams struct {
+	PlayerID int64
+	FriendID int64
+}
+
+func (q *Queries) AcceptFriendRequest(ctx context.Context, arg AcceptFriendRequestParams) error {
+	_, err := q.db.ExecContext(ctx, acceptFriendRequest, arg.PlayerID, arg.FriendID)
+	return err
+}
+
+const banPlayer = `-- name: BanPlayer :exec
+UPDATE users
+SET banned_until = ?
+WHERE id = (
+    SELECT user_id FROM players
+    WHERE players.id = ?
+)
+`
+
+type BanPlayerParams struct {
+	BannedUntil sql.NullTime
+	ID          int64
+}
+
+func (q *Queries) BanPlayer(ctx context.Context, arg BanPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, banPlayer, arg.BannedUntil, arg.ID)
+	return err
+}
+
+const clearReportsByReporter = `-- name: ClearReportsByReporter :exec
+UPDATE reports
+SET reporter_player_id = NULL
+WHERE reporter_player_id = ?
+`
+
+func (q *Queries) ClearReportsByReporter(ctx context.Context, reporterPlayerID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, clearReportsByReporter, reporterPlayerID)
+	return err
+}
+
+const clearRoundWinner = `-- name: ClearRoundWinner :exec
+UPDATE rounds
+SET winner_player_id = NULL
+WHERE winner_player_id = ?
+`
+
+func (q *Queries) ClearRoundWinner(ctx context.Context, winnerPlayerID sql.NullInt64) error {
+	_, err := q.db.ExecContext(ctx, clearRoundWinner, winnerPlayerID)
+	return err
+}
+
+const countOpenReports = `-- name: CountOpenReports :one
+SELECT COUNT(*) FROM reports
+WHERE reporter_player_id = ? AND target_player_id = ? AND status = 'open'
+`
+
+type CountOpenReportsParams struct {
+	ReporterPlayerID sql.NullInt64
+	TargetPlayerID   int64
+}
+
+func (q *Queries) CountOpenReports(ctx context.Context, arg CountOpenReportsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOpenReports, arg.ReporterPlayerID, arg.TargetPlayerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createChatMessage = `-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    player_id, channel, target, text, filtered
+) VALUES (
+    ?, ?, ?, ?, ?
+)
+`
+
+type CreateChatMessageParams struct {
+	PlayerID int64
+	Channel  int64
+	Target   string
+	Text     string
+	Filtered bool
+}
+
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) error {
+	_, err := q.db.ExecContext(ctx, createChatMessage,
+		arg.PlayerID,
+		arg.Channel,
+		arg.Target,
+		arg.Text,
+		arg.Filtered,
+	)
+	return err
+}
+
+const createExternalUser = `-- name: CreateExternalUser :one
+INSERT INTO users (
+    username, password_hash, external_id
+) VALUES (
+    ?, '', ?
+)
+RETURNING id, username, password_hash, role, muted_until, banned_until, last_address, external_id
+`
+
+type CreateExternalUserParams struct {
+	Username   string
+	ExternalID sql.NullString
+}
+
+func (q *Queries) CreateExternalUser(ctx context.Context, arg CreateExternalUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, createExternalUser, arg.Username, arg.ExternalID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+		&i.MutedUntil,
+		&i.BannedUntil,
+		&i.LastAddress,
+		&i.ExternalID,
+	)
+	return i, err
+}
+
+const createFriendRequest = `-- name: CreateFriendRequest :exec
+INSERT INTO friends (
+    player_id, friend_id
+) VALUES (
+    ?, ?
+)
+`
+
+type CreateFriendRequestParams struct {
+	PlayerID int64
+	FriendID int64
+}
+
+func (q *Queries) CreateFriendRequest(ctx context.Context, arg CreateFriendRequestParams) error {
+	_, err := q.db.ExecContext(ctx, createFriendRequest, arg.PlayerID, arg.FriendID)
+	return err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
 INSERT INTO players (
-    user_id, name,color
+    user_id, name,color, name_key
 ) VALUES (
-    ?, ?, ?
+    ?, ?, ?, ?
 )
-RETURNING id, user_id, name, best_score, color
+RETURNING id, user_id, name, best_score, color, name_changed_at, name_key
 `
 
 type CreatePlayerParams struct {
-	UserID int64
-	Name   string
-	Color  int64
+	UserID  int64
+	Name    string
+	Color   int64
+	NameKey string
 }
 
 func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error) {
-	row := q.db.QueryRowContext(ctx, createPlayer, arg.UserID, arg.Name, arg.Color)
+	row := q.db.QueryRowContext(ctx, createPlayer,
+		arg.UserID,
+		arg.Name,
+		arg.Color,
+		arg.NameKey,
+	)
 	var i Player
 	err := row.Scan(
 		&i.ID,
@@ -33,17 +190,106 @@ func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Pla
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.NameChangedAt,
+		&i.NameKey,
+	)
+	return i, err
+}
+
+const createReport = `-- name: CreateReport :one
+INSERT INTO reports (
+    reporter_player_id, target_player_id, reason, text, chat_snapshot, best_score, mass
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+)
+RETURNING id, reporter_player_id, target_player_id, reason, text, chat_snapshot, best_score, mass, status, resolution, created_at, resolved_at
+`
+
+type CreateReportParams struct {
+	ReporterPlayerID sql.NullInt64
+	TargetPlayerID   int64
+	Reason           int64
+	Text             string
+	ChatSnapshot     string
+	BestScore        int64
+	Mass             float64
+}
+
+func (q *Queries) CreateReport(ctx context.Context, arg CreateReportParams) (Report, error) {
+	row := q.db.QueryRowContext(ctx, createReport,
+		arg.ReporterPlayerID,
+		arg.TargetPlayerID,
+		arg.Reason,
+		arg.Text,
+		arg.ChatSnapshot,
+		arg.BestScore,
+		arg.Mass,
+	)
+	var i Report
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterPlayerID,
+		&i.TargetPlayerID,
+		&i.Reason,
+		&i.Text,
+		&i.ChatSnapshot,
+		&i.BestScore,
+		&i.Mass,
+		&i.Status,
+		&i.Resolution,
+		&i.CreatedAt,
+		&i.ResolvedAt,
 	)
 	return i, err
 }
 
+const createRound = `-- name: CreateRound :one
+INSERT INTO rounds (
+    started_at
+) VALUES (
+    CURRENT_TIMESTAMP
+)
+RETURNING id, started_at, ended_at, winner_player_id
+`
+
+func (q *Queries) CreateRound(ctx context.Context) (Round, error) {
+	row := q.db.QueryRowContext(ctx, createRound)
+	var i Round
+	err := row.Scan(
+		&i.ID,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.WinnerPlayerID,
+	)
+	return i, err
+}
+
+const createRoundResult = `-- name: CreateRoundResult :exec
+INSERT INTO round_results (
+    round_id, player_id, placement
+) VALUES (
+    ?, ?, ?
+)
+`
+
+type CreateRoundResultParams struct {
+	RoundID   int64
+	PlayerID  int64
+	Placement int64
+}
+
+func (q *Queries) CreateRoundResult(ctx context.Context, arg CreateRoundResultParams) error {
+	_, err := q.db.ExecContext(ctx, createRoundResult, arg.RoundID, arg.PlayerID, arg.Placement)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     username, password_hash
 ) VALUES (
     ?, ?
 )
-RETURNING id, username, password_hash
+RETURNING id, username, password_hash, role, muted_until, banned_until, last_address, external_id
 `
 
 type CreateUserParams struct {
@@ -54,12 +300,182 @@ type CreateUserParams struct {
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
 	row := q.db.QueryRowContext(ctx, createUser, arg.Username, arg.PasswordHash)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+		&i.MutedUntil,
+		&i.BannedUntil,
+		&i.LastAddress,
+		&i.ExternalID,
+	)
+	return i, err
+}
+
+const deleteChatMessagesBefore = `-- name: DeleteChatMessagesBefore :exec
+DELETE FROM chat_messages
+WHERE sent_at < ?
+`
+
+func (q *Queries) DeleteChatMessagesBefore(ctx context.Context, sentAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteChatMessagesBefore, sentAt)
+	return err
+}
+
+const deleteFriendship = `-- name: DeleteFriendship :exec
+DELETE FROM friends
+WHERE (player_id = ?1 AND friend_id = ?2)
+    OR (player_id = ?2 AND friend_id = ?1)
+`
+
+type DeleteFriendshipParams struct {
+	PlayerID int64
+	FriendID int64
+}
+
+func (q *Queries) DeleteFriendship(ctx context.Context, arg DeleteFriendshipParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFriendship, arg.PlayerID, arg.FriendID)
+	return err
+}
+
+const deleteOldestChatMessages = `-- name: DeleteOldestChatMessages :exec
+DELETE FROM chat_messages
+WHERE id <= (
+    SELECT id FROM chat_messages
+    ORDER BY id DESC
+    LIMIT 1
+    OFFSET ?
+)
+`
+
+func (q *Queries) DeleteOldestChatMessages(ctx context.Context, offset int64) error {
+	_, err := q.db.ExecContext(ctx, deleteOldestChatMessages, offset)
+	return err
+}
+
+const deletePlayer = `-- name: DeletePlayer :exec
+DELETE FROM players
+WHERE id = ?
+`
+
+func (q *Queries) DeletePlayer(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayer, id)
+	return err
+}
+
+const deletePlayerChatMessages = `-- name: DeletePlayerChatMessages :exec
+DELETE FROM chat_messages
+WHERE player_id = ?
+`
+
+func (q *Queries) DeletePlayerChatMessages(ctx context.Context, playerID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerChatMessages, playerID)
+	return err
+}
+
+const deletePlayerFriends = `-- name: DeletePlayerFriends :exec
+DELETE FROM friends
+WHERE player_id = ?1 OR friend_id = ?1
+`
+
+func (q *Queries) DeletePlayerFriends(ctx context.Context, playerID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerFriends, playerID)
+	return err
+}
+
+const deletePlayerReports = `-- name: DeletePlayerReports :exec
+DELETE FROM reports
+WHERE target_player_id = ?
+`
+
+func (q *Queries) DeletePlayerReports(ctx context.Context, targetPlayerID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerReports, targetPlayerID)
+	return err
+}
+
+const deletePlayerRoundResults = `-- name: DeletePlayerRoundResults :exec
+DELETE FROM round_results
+WHERE player_id = ?
+`
+
+func (q *Queries) DeletePlayerRoundResults(ctx context.Context, playerID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePlayerRoundResults, playerID)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE id = ?
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	return err
+}
+
+const finishRound = `-- name: FinishRound :exec
+UPDATE rounds
+SET ended_at = CURRENT_TIMESTAMP, winner_player_id = ?
+WHERE id = ?
+`
+
+type FinishRoundParams struct {
+	WinnerPlayerID sql.NullInt64
+	ID             int64
+}
+
+func (q *Queries) FinishRound(ctx context.Context, arg FinishRoundParams) error {
+	_, err := q.db.ExecContext(ctx, finishRound, arg.WinnerPlayerID, arg.ID)
+	return err
+}
+
+const getFriendship = `-- name: GetFriendship :one
+SELECT player_id, friend_id, status, created_at FROM friends
+WHERE (player_id = ?1 AND friend_id = ?2)
+    OR (player_id = ?2 AND friend_id = ?1)
+LIMIT 1
+`
+
+type GetFriendshipParams struct {
+	PlayerID int64
+	FriendID int64
+}
+
+func (q *Queries) GetFriendship(ctx context.Context, arg GetFriendshipParams) (Friend, error) {
+	row := q.db.QueryRowContext(ctx, getFriendship, arg.PlayerID, arg.FriendID)
+	var i Friend
+	err := row.Scan(
+		&i.PlayerID,
+		&i.FriendID,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPlayerByID = `-- name: GetPlayerByID :one
+SELECT id, user_id, name, best_score, color, name_changed_at, name_key FROM players
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetPlayerByID(ctx context.Context, id int64) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerByID, id)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+		&i.NameChangedAt,
+		&i.NameKey,
+	)
 	return i, err
 }
 
 const getPlayerByName = `-- name: GetPlayerByName :one
-SELECT id, user_id, name, best_score, color FROM players
+SELECT id, user_id, name, best_score, color, name_changed_at, name_key FROM players
 WHERE name LIKE ?
 LIMIT 1
 `
@@ -73,12 +489,35 @@ func (q *Queries) GetPlayerByName(ctx context.Context, name string) (Player, err
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.NameChangedAt,
+		&i.NameKey,
+	)
+	return i, err
+}
+
+const getPlayerByNameKey = `-- name: GetPlayerByNameKey :one
+SELECT id, user_id, name, best_score, color, name_changed_at, name_key FROM players
+WHERE name_key = ?
+LIMIT 1
+`
+
+func (q *Queries) GetPlayerByNameKey(ctx context.Context, nameKey string) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerByNameKey, nameKey)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.BestScore,
+		&i.Color,
+		&i.NameChangedAt,
+		&i.NameKey,
 	)
 	return i, err
 }
 
 const getPlayerByUserID = `-- name: GetPlayerByUserID :one
-SELECT id, user_id, name, best_score, color FROM players
+SELECT id, user_id, name, best_score, color, name_changed_at, name_key FROM players
 WHERE user_id = ? LIMIT 1
 `
 
@@ -91,6 +530,8 @@ func (q *Queries) GetPlayerByUserID(ctx context.Context, userID int64) (Player,
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.NameChangedAt,
+		&i.NameKey,
 	)
 	return i, err
 }
@@ -110,6 +551,89 @@ func (q *Queries) GetPlayerRank(ctx context.Context, id int64) (int64, error) {
 	return rank, err
 }
 
+const getRecentChatMessages = `-- name: GetRecentChatMessages :many
+SELECT chat_messages.id, chat_messages.player_id, chat_messages.channel, chat_messages.target, chat_messages.text, chat_messages.filtered, chat_messages.sent_at, players.name
+FROM chat_messages
+JOIN players ON players.id = chat_messages.player_id
+WHERE chat_messages.channel IN (?, ?)
+ORDER BY chat_messages.id DESC
+LIMIT ?
+`
+
+type GetRecentChatMessagesParams struct {
+	Channel   int64
+	Channel_2 int64
+	Limit     int64
+}
+
+type GetRecentChatMessagesRow struct {
+	ID       int64
+	PlayerID int64
+	Channel  int64
+	Target   string
+	Text     string
+	Filtered bool
+	SentAt   time.Time
+	Name     string
+}
+
+func (q *Queries) GetRecentChatMessages(ctx context.Context, arg GetRecentChatMessagesParams) ([]GetRecentChatMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentChatMessages, arg.Channel, arg.Channel_2, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecentChatMessagesRow
+	for rows.Next() {
+		var i GetRecentChatMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.Channel,
+			&i.Target,
+			&i.Text,
+			&i.Filtered,
+			&i.SentAt,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReport = `-- name: GetReport :one
+SELECT id, reporter_player_id, target_player_id, reason, text, chat_snapshot, best_score, mass, status, resolution, created_at, resolved_at FROM reports
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetReport(ctx context.Context, id int64) (Report, error) {
+	row := q.db.QueryRowContext(ctx, getReport, id)
+	var i Report
+	err := row.Scan(
+		&i.ID,
+		&i.ReporterPlayerID,
+		&i.TargetPlayerID,
+		&i.Reason,
+		&i.Text,
+		&i.ChatSnapshot,
+		&i.BestScore,
+		&i.Mass,
+		&i.Status,
+		&i.Resolution,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
 const getTopScores = `-- name: GetTopScores :many
 SELECT name, best_score
 FROM players
@@ -151,18 +675,332 @@ func (q *Queries) GetTopScores(ctx context.Context, arg GetTopScoresParams) ([]G
 	return items, nil
 }
 
+const getUserByExternalID = `-- name: GetUserByExternalID :one
+SELECT id, username, password_hash, role, muted_until, banned_until, last_address, external_id FROM users
+WHERE external_id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserByExternalID(ctx context.Context, externalID sql.NullString) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByExternalID, externalID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+		&i.MutedUntil,
+		&i.BannedUntil,
+		&i.LastAddress,
+		&i.ExternalID,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, password_hash, role, muted_until, banned_until, last_address, external_id FROM users
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+		&i.MutedUntil,
+		&i.BannedUntil,
+		&i.LastAddress,
+		&i.ExternalID,
+	)
+	return i, err
+}
+
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, password_hash FROM users
+SELECT id, username, password_hash, role, muted_until, banned_until, last_address, external_id FROM users
 WHERE username = ? LIMIT 1
 `
 
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
 	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
 	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.PasswordHash)
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+		&i.MutedUntil,
+		&i.BannedUntil,
+		&i.LastAddress,
+		&i.ExternalID,
+	)
 	return i, err
 }
 
+const listFriends = `-- name: ListFriends :many
+SELECT players.id, players.name, friends.status, friends.player_id AS requester_id
+FROM friends
+JOIN players ON players.id = CASE
+    WHEN friends.player_id = ?1 THEN friends.friend_id
+    ELSE friends.player_id
+END
+WHERE friends.player_id = ?1 OR friends.friend_id = ?1
+ORDER BY players.name
+`
+
+type ListFriendsRow struct {
+	ID          int64
+	Name        string
+	Status      string
+	RequesterID int64
+}
+
+func (q *Queries) ListFriends(ctx context.Context, playerID int64) ([]ListFriendsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFriends, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFriendsRow
+	for rows.Next() {
+		var i ListFriendsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Status,
+			&i.RequesterID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayersWithoutNameKey = `-- name: ListPlayersWithoutNameKey :many
+SELECT id, name FROM players
+WHERE name_key = ''
+`
+
+type ListPlayersWithoutNameKeyRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListPlayersWithoutNameKey(ctx context.Context) ([]ListPlayersWithoutNameKeyRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPlayersWithoutNameKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPlayersWithoutNameKeyRow
+	for rows.Next() {
+		var i ListPlayersWithoutNameKeyRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReports = `-- name: ListReports :many
+SELECT reports.id, reports.reporter_player_id, reports.target_player_id, reports.reason, reports.text, reports.chat_snapshot, reports.best_score, reports.mass, reports.status, reports.resolution, reports.created_at, reports.resolved_at, target.name AS target_name, reporter.name AS reporter_name
+FROM reports
+JOIN players target ON target.id = reports.target_player_id
+LEFT JOIN players reporter ON reporter.id = reports.reporter_player_id
+WHERE reports.status = ?
+ORDER BY reports.id
+LIMIT ?
+OFFSET ?
+`
+
+type ListReportsParams struct {
+	Status string
+	Limit  int64
+	Offset int64
+}
+
+type ListReportsRow struct {
+	ID               int64
+	ReporterPlayerID sql.NullInt64
+	TargetPlayerID   int64
+	Reason           int64
+	Text             string
+	ChatSnapshot     string
+	BestScore        int64
+	Mass             float64
+	Status           string
+	Resolution       string
+	CreatedAt        time.Time
+	ResolvedAt       sql.NullTime
+	TargetName       string
+	ReporterName     sql.NullString
+}
+
+func (q *Queries) ListReports(ctx context.Context, arg ListReportsParams) ([]ListReportsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listReports, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReportsRow
+	for rows.Next() {
+		var i ListReportsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReporterPlayerID,
+			&i.TargetPlayerID,
+			&i.Reason,
+			&i.Text,
+			&i.ChatSnapshot,
+			&i.BestScore,
+			&i.Mass,
+			&i.Status,
+			&i.Resolution,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.TargetName,
+			&i.ReporterName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserRestrictionsByAddress = `-- name: ListUserRestrictionsByAddress :many
+SELECT muted_until, banned_until FROM users
+WHERE last_address = ? AND (muted_until IS NOT NULL OR banned_until IS NOT NULL)
+`
+
+type ListUserRestrictionsByAddressRow struct {
+	MutedUntil  sql.NullTime
+	BannedUntil sql.NullTime
+}
+
+func (q *Queries) ListUserRestrictionsByAddress(ctx context.Context, lastAddress string) ([]ListUserRestrictionsByAddressRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserRestrictionsByAddress, lastAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserRestrictionsByAddressRow
+	for rows.Next() {
+		var i ListUserRestrictionsByAddressRow
+		if err := rows.Scan(&i.MutedUntil, &i.BannedUntil); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mutePlayer = `-- name: MutePlayer :exec
+UPDATE users
+SET muted_until = ?
+WHERE id = (
+    SELECT user_id FROM players
+    WHERE players.id = ?
+)
+`
+
+type MutePlayerParams struct {
+	MutedUntil sql.NullTime
+	ID         int64
+}
+
+func (q *Queries) MutePlayer(ctx context.Context, arg MutePlayerParams) error {
+	_, err := q.db.ExecContext(ctx, mutePlayer, arg.MutedUntil, arg.ID)
+	return err
+}
+
+const resolveReport = `-- name: ResolveReport :exec
+UPDATE reports
+SET status = 'resolved', resolution = ?, resolved_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type ResolveReportParams struct {
+	Resolution string
+	ID         int64
+}
+
+func (q *Queries) ResolveReport(ctx context.Context, arg ResolveReportParams) error {
+	_, err := q.db.ExecContext(ctx, resolveReport, arg.Resolution, arg.ID)
+	return err
+}
+
+const searchPlayerChatMessages = `-- name: SearchPlayerChatMessages :many
+SELECT id, player_id, channel, target, text, filtered, sent_at FROM chat_messages
+WHERE player_id = ? AND text LIKE ?
+ORDER BY id DESC
+LIMIT ?
+`
+
+type SearchPlayerChatMessagesParams struct {
+	PlayerID int64
+	Text     string
+	Limit    int64
+}
+
+func (q *Queries) SearchPlayerChatMessages(ctx context.Context, arg SearchPlayerChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, searchPlayerChatMessages, arg.PlayerID, arg.Text, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.Channel,
+			&i.Target,
+			&i.Text,
+			&i.Filtered,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updatePlayerBestScore = `-- name: UpdatePlayerBestScore :exec
 UPDATE players
 SET best_score = ?
@@ -178,3 +1016,84 @@ func (q *Queries) UpdatePlayerBestScore(ctx context.Context, arg UpdatePlayerBes
 	_, err := q.db.ExecContext(ctx, updatePlayerBestScore, arg.BestScore, arg.ID)
 	return err
 }
+
+const updatePlayerColor = `-- name: UpdatePlayerColor :exec
+UPDATE players
+SET color = ?
+WHERE id = ?
+`
+
+type UpdatePlayerColorParams struct {
+	Color int64
+	ID    int64
+}
+
+func (q *Queries) UpdatePlayerColor(ctx context.Context, arg UpdatePlayerColorParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerColor, arg.Color, arg.ID)
+	return err
+}
+
+const updatePlayerName = `-- name: UpdatePlayerName :exec
+UPDATE players
+SET name = ?, name_key = ?, name_changed_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdatePlayerNameParams struct {
+	Name    string
+	NameKey string
+	ID      int64
+}
+
+func (q *Queries) UpdatePlayerName(ctx context.Context, arg UpdatePlayerNameParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerName, arg.Name, arg.NameKey, arg.ID)
+	return err
+}
+
+const updatePlayerNameKey = `-- name: UpdatePlayerNameKey :exec
+UPDATE players
+SET name_key = ?
+WHERE id = ?
+`
+
+type UpdatePlayerNameKeyParams struct {
+	NameKey string
+	ID      int64
+}
+
+func (q *Queries) UpdatePlayerNameKey(ctx context.Context, arg UpdatePlayerNameKeyParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerNameKey, arg.NameKey, arg.ID)
+	return err
+}
+
+const updateUserAddress = `-- name: UpdateUserAddress :exec
+UPDATE users
+SET last_address = ?
+WHERE id = ?
+`
+
+type UpdateUserAddressParams struct {
+	LastAddress string
+	ID          int64
+}
+
+func (q *Queries) UpdateUserAddress(ctx context.Context, arg UpdateUserAddressParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserAddress, arg.LastAddress, arg.ID)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = ?
+WHERE id = ?
+`
+
+type UpdateUserPasswordParams struct {
+	PasswordHash string
+	ID           int64
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.PasswordHash, arg.ID)
+	return err
+}
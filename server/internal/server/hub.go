@@ -1,19 +1,27 @@
 package server
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	_ "embed"
+	"errors"
 	"log"
+	"math"
 	"math/rand/v2"
 	"net/http"
-	"path"
+	"runtime"
+	"server/internal/server/auth"
 	"server/internal/server/db"
 	"server/internal/server/objects"
+	"server/internal/server/profanity"
 	"server/pkg/packets"
+	"slices"
+	"sync/atomic"
 	"time"
 
-	_ "modernc.org/sqlite"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 )
 
 //go:embed db/config/schema.sql
@@ -23,19 +31,42 @@ var schemaGenSql string
 type DbTx struct {
 	Ctx     context.Context
 	Queries *db.Queries
+	pool    *sql.DB
 }
 
 func (h *Hub) NewDbTx() *DbTx {
 	return &DbTx{
 		Ctx:     context.Background(),
 		Queries: db.New(h.dbPool),
+		pool:    h.dbPool,
 	}
 }
 
+// Runs the queries together in a single transaction, which is rolled back if run returns an error
+func (dbTx *DbTx) InTransaction(run func(queries *db.Queries) error) error {
+	tx, err := dbTx.pool.BeginTx(dbTx.Ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := run(dbTx.Queries.WithTx(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Whether the error is from breaking a unique constraint, like two players saving the same name at once
+func IsUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
+
 type SharedGameObjects struct {
 	// The ID of the player is the ID of the client
-	Players *objects.SharedCollection[*objects.Player]
-	Spores  *objects.SharedCollection[*objects.Spore]
+	Players  *objects.SharedCollection[*objects.Player]
+	Spores   *objects.SharedCollection[*objects.Spore]
+	Viruses  *objects.SharedCollection[*objects.Virus]
+	PowerUps *objects.SharedCollection[*objects.PowerUp]
 }
 
 type ClientStateHandler interface {
@@ -54,9 +85,23 @@ type ClientStateHandler interface {
 // A structure for the connected client to interface with the hub
 type ClientInterfacer interface {
 	Id() uint64
+
+	// The IP address the client connected from, which guests are held to account by
+	Address() string
+
+	// Queues a message for the client to handle on its own goroutine. Everything which changes the client's state
+	// happens there, one thing at a time, so states never have to worry about other goroutines.
 	ProcessMessage(senderId uint64, message packets.Msg)
 
+	// Queues work for the client's own goroutine, like applying the result of something slow done elsewhere
+	Enqueue(job func())
+
+	// Only to be called from the client's own goroutine
 	SetState(newState ClientStateHandler)
+
+	// The state the client is in right now. Other goroutines can check which state it is, but what's in it belongs to
+	// the client's own goroutine.
+	State() ClientStateHandler
 	// Sets the client's ID and anything else that needs to be initialized
 	Initialize(id uint64)
 
@@ -83,7 +128,35 @@ type ClientInterfacer interface {
 
 	SharedGameObjects() *SharedGameObjects
 
-	// Close the client's connections and cleanup
+	// The gameplay rules the hub was configured with
+	Rules() *Rules
+
+	// The battle royale round being played, or nil if the hub isn't running rounds
+	Round() *Round
+
+	// The players of all logged in clients, whether or not they're in the game right now
+	OnlinePlayers() *objects.SharedCollection[*objects.Player]
+
+	// All the clients connected to the hub, including this one
+	Clients() *objects.SharedCollection[ClientInterfacer]
+
+	// Checks chat messages and names for banned words
+	ChatFilter() *profanity.Filter
+
+	// The rules usernames and passwords have to follow
+	AccountPolicy() *auth.Policy
+
+	// Checks the credentials players log in with
+	Authenticator() auth.Authenticator
+
+	// Hashes the passwords players register or change to
+	PasswordHasher() *auth.Hasher
+
+	// Where logins and registrations are processed, away from the client's read pump
+	LoginWorkers() *WorkerPool
+
+	// Close the client's connections and cleanup, once the client's own goroutine gets to it. Safe to call from
+	// anywhere, and packets sent before it are still delivered.
 	Close(reason string)
 }
 
@@ -104,47 +177,152 @@ type Hub struct {
 	dbPool *sql.DB
 
 	SharedGameObjects *SharedGameObjects
+
+	// The ID of each online player is the ID of their client
+	OnlinePlayers *objects.SharedCollection[*objects.Player]
+
+	// Swapped out whenever the rules are reloaded, see SetRules
+	rules atomic.Pointer[Rules]
+
+	ChatFilter *profanity.Filter
+
+	AccountPolicy *auth.Policy
+
+	Authenticator auth.Authenticator
+
+	PasswordHasher *auth.Hasher
+
+	LoginWorkers *WorkerPool
+
+	// Loads the configuration again and applies what it can while the server's running, returning why not if the
+	// new configuration is invalid. Set by whoever configured the hub, and used by the admin API.
+	ReloadConfig func() error
+
+	// Only set in battle royale mode
+	Round *Round
 }
 
-func NewHub(dataDirPath string) *Hub {
-	dbPool, err := sql.Open("sqlite", path.Join(dataDirPath, "db.sqlite"))
+// Players log in with the passwords they registered in the database unless another authenticator is given
+func NewHub(dbPath string, rules *Rules, chatFilter *profanity.Filter, accountPolicy *auth.Policy, passwordHasher *auth.Hasher, authenticator auth.Authenticator) *Hub {
+	dbPool, err := sql.Open("sqlite", dbPath)
 
 	if err != nil {
 		log.Fatalf("Error opening database: %v", err)
 	}
+	if authenticator == nil {
+		authenticator = auth.NewDatabaseAuthenticator(db.New(dbPool), passwordHasher)
+	}
 
-	return &Hub{
+	hub := &Hub{
 		Clients:        objects.NewSharedCollection[ClientInterfacer](),
 		BroadcastChan:  make(chan *packets.Packet),
 		RegisterChan:   make(chan ClientInterfacer),
 		UnregisterChan: make(chan ClientInterfacer),
 		dbPool:         dbPool,
 		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
+			Players:  objects.NewSharedCollection[*objects.Player](),
+			Spores:   objects.NewSharedCollection[*objects.Spore](),
+			Viruses:  objects.NewSharedCollection[*objects.Virus](),
+			PowerUps: objects.NewSharedCollection[*objects.PowerUp](),
 		},
+		OnlinePlayers:  objects.NewSharedCollection[*objects.Player](),
+		ChatFilter:     chatFilter,
+		AccountPolicy:  accountPolicy,
+		Authenticator:  authenticator,
+		PasswordHasher: passwordHasher,
+		LoginWorkers:   NewWorkerPool(runtime.NumCPU(), maxQueuedLogins),
 	}
+	hub.rules.Store(rules)
+	if rules.Mode == ModeRoyale {
+		hub.Round = newRound(hub)
+	}
+	return hub
+}
+
+// Logins and registrations waiting on a free worker, past which players are told to try again later
+const maxQueuedLogins int = 256
+
+// How many of the biggest players are shown on the live leaderboard
+const LiveLeaderboardSize int = 10
+
+// The current gameplay rules. They can be reloaded at any time, so code which uses several of them together should
+// only get them once.
+func (h *Hub) Rules() *Rules {
+	return h.rules.Load()
 }
 
-const MaxSpores int = 1000
+// Swaps in reloaded rules for everything to pick up from then on. The mode, the number of teams and the arena shape
+// the whole world, so changes to them are held back until the server is restarted.
+func (h *Hub) SetRules(rules *Rules) {
+	current := h.Rules()
+	if rules.Mode != current.Mode {
+		log.Println("The game mode can only be changed by restarting the server, keeping the current one")
+		rules.Mode = current.Mode
+	}
+	if rules.TeamCount != current.TeamCount {
+		log.Println("The number of teams can only be changed by restarting the server, keeping the current one")
+		rules.TeamCount = current.TeamCount
+	}
+	if rules.Arena != current.Arena {
+		log.Println("The arena can only be changed by restarting the server, keeping the current one")
+		rules.Arena = current.Arena
+	}
+	h.rules.Store(rules)
+	log.Println("Applied the reloaded rules")
+}
 
 func (h *Hub) newSpore() *objects.Spore {
 	sporeRadius := max(rand.NormFloat64()*3+10, 5)
-	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
+	x, y := objects.SpawnCoords(&h.Rules().Arena, sporeRadius, h.Rules().SpawnTries, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
 	return &objects.Spore{X: x, Y: y, Radius: sporeRadius}
 }
 
+func (h *Hub) newVirus() *objects.Virus {
+	x, y := objects.SpawnCoords(&h.Rules().Arena, h.Rules().VirusRadius, h.Rules().SpawnTries, h.SharedGameObjects.Players, nil)
+	return &objects.Virus{X: x, Y: y, Radius: h.Rules().VirusRadius}
+}
+
+func (h *Hub) newPowerUp() *objects.PowerUp {
+	x, y := objects.SpawnCoords(&h.Rules().Arena, h.Rules().PowerUpRadius, h.Rules().SpawnTries, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
+	return &objects.PowerUp{X: x, Y: y, Radius: h.Rules().PowerUpRadius, Kind: objects.RandomPowerUpKind()}
+}
+
 func (h *Hub) Run() {
 	log.Println("Initializing database")
 	_, err := h.dbPool.ExecContext(context.Background(), schemaGenSql)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err := h.migrateColumns(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err := h.fillNameKeys(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err := h.uniqueNameKeys(context.Background()); err != nil {
+		log.Fatal(err)
+	}
 	log.Println("Placing spores...")
-	for i := 0; i < MaxSpores; i++ {
+	for i := 0; i < h.Rules().MaxSpores; i++ {
 		h.SharedGameObjects.Spores.Add(h.newSpore())
 	}
-	go h.replenishSporesLoop(2 * time.Second)
+	log.Println("Placing viruses...")
+	for i := 0; i < h.Rules().MaxViruses; i++ {
+		h.SharedGameObjects.Viruses.Add(h.newVirus())
+	}
+	go h.replenishSporesLoop(h.Rules().SporeReplenishInterval)
+	go h.replenishVirusesLoop(h.Rules().VirusReplenishInterval)
+	go h.spawnPowerUpsLoop(h.Rules().PowerUpSpawnInterval)
+	go h.moveObjectsLoop(h.Rules().TickInterval)
+	if h.Rules().Mode == ModeTeams {
+		go h.teamScoreboardLoop(h.Rules().TeamScoreboardInterval)
+	}
+	go h.ChatFilter.Watch(5 * time.Second)
+	go h.pruneChatLoop(time.Hour)
+	go h.liveLeaderboardLoop(h.Rules().LiveLeaderboardInterval)
+	if h.Round != nil {
+		go h.Round.run(250 * time.Millisecond)
+	}
 	log.Println("Awaiting client registrations...")
 	for {
 		select {
@@ -152,6 +330,11 @@ func (h *Hub) Run() {
 			client.Initialize(h.Clients.Add(client))
 		case client := <-h.UnregisterChan:
 			h.Clients.Remove(client.Id())
+			// The client has stopped by the time it's unregistered, so its player can be read here
+			if player, online := h.OnlinePlayers.Get(client.Id()); online && player.Party != nil {
+				RemoveFromParty(h.Clients, client.Id(), player.Party)
+			}
+			h.OnlinePlayers.Remove(client.Id())
 		case packet := <-h.BroadcastChan:
 			h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
 				if clientId != packet.SenderId {
@@ -183,8 +366,9 @@ func (h *Hub) replenishSporesLoop(rate time.Duration) {
 	defer ticker.Stop()
 
 	for range ticker.C {
+		rate = FollowInterval(ticker, rate, h.Rules().SporeReplenishInterval)
 		sporesRemaining := h.SharedGameObjects.Spores.Len()
-		diff := MaxSpores - sporesRemaining
+		diff := h.Rules().MaxSpores - sporesRemaining
 
 		if diff <= 0 {
 			continue
@@ -193,7 +377,7 @@ func (h *Hub) replenishSporesLoop(rate time.Duration) {
 		log.Printf("%d spores remain - going to replenish %d spores", sporesRemaining, diff)
 
 		// Don't really want to spawn too many at a time, otherwise it can cause a lag spike
-		for i := 0; i < min(diff, 10); i++ {
+		for i := 0; i < min(diff, h.Rules().SporeReplenishBatch); i++ {
 			spore := h.newSpore()
 			sporeId := h.SharedGameObjects.Spores.Add(spore)
 
@@ -207,3 +391,264 @@ func (h *Hub) replenishSporesLoop(rate time.Duration) {
 		}
 	}
 }
+
+func (h *Hub) replenishVirusesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		rate = FollowInterval(ticker, rate, h.Rules().VirusReplenishInterval)
+		virusesRemaining := h.SharedGameObjects.Viruses.Len()
+		diff := h.Rules().MaxViruses - virusesRemaining
+
+		if diff <= 0 {
+			continue
+		}
+
+		log.Printf("%d viruses remain - going to replenish %d viruses", virusesRemaining, diff)
+
+		// Viruses are few and far between, so one at a time is plenty
+		virus := h.newVirus()
+		virusId := h.SharedGameObjects.Viruses.Add(virus)
+
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewVirus(virusId, virus),
+		}
+	}
+}
+
+func (h *Hub) spawnPowerUpsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		rate = FollowInterval(ticker, rate, h.Rules().PowerUpSpawnInterval)
+		if h.SharedGameObjects.PowerUps.Len() >= h.Rules().MaxPowerUps {
+			continue
+		}
+
+		powerUp := h.newPowerUp()
+		powerUpId := h.SharedGameObjects.PowerUps.Add(powerUp)
+
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewPowerUp(powerUpId, powerUp),
+		}
+	}
+}
+
+// Sends out the combined mass of each team's players
+func (h *Hub) teamScoreboardLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		rate = FollowInterval(ticker, rate, h.Rules().TeamScoreboardInterval)
+		teamScores := make([]*packets.TeamScoreMessage, h.Rules().TeamCount)
+		for i := range teamScores {
+			team := i + 1
+			teamScores[i] = &packets.TeamScoreMessage{
+				Team:  uint32(team),
+				Name:  objects.TeamName(team),
+				Color: objects.TeamColor(team),
+			}
+		}
+
+		h.SharedGameObjects.Players.ForEach(func(_ uint64, player *objects.Player) {
+			if player.Team < 1 || player.Team > len(teamScores) {
+				return
+			}
+			teamScore := teamScores[player.Team-1]
+			teamScore.Mass += player.Mass()
+			teamScore.Players++
+		})
+
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewTeamScoreboard(teamScores),
+		}
+	}
+}
+
+// Sends every in-game player the biggest players right now, along with where they stand themselves
+func (h *Hub) liveLeaderboardLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	type standing struct {
+		playerId uint64
+		player   *objects.Player
+		mass     float64
+	}
+
+	for range ticker.C {
+		rate = FollowInterval(ticker, rate, h.Rules().LiveLeaderboardInterval)
+		standings := make([]standing, 0, h.SharedGameObjects.Players.Len())
+		h.SharedGameObjects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+			standings = append(standings, standing{playerId, player, player.Mass()})
+		})
+		if len(standings) == 0 {
+			continue
+		}
+		slices.SortFunc(standings, func(a, b standing) int {
+			return cmp.Compare(b.mass, a.mass)
+		})
+
+		entries := make([]*packets.LiveLeaderboardEntryMessage, 0, LiveLeaderboardSize)
+		for i, s := range standings[:min(len(standings), LiveLeaderboardSize)] {
+			entries = append(entries, &packets.LiveLeaderboardEntryMessage{
+				Rank:     uint32(i + 1),
+				PlayerId: s.playerId,
+				Name:     s.player.Name,
+				Mass:     s.mass,
+				Color:    s.player.DisplayColor(),
+			})
+		}
+
+		// The player ID is the ID of the client, so each player can be told their own rank
+		for i, s := range standings {
+			if client, exists := h.Clients.Get(s.playerId); exists {
+				client.SocketSend(packets.NewLiveLeaderboard(entries, i+1, s.mass))
+			}
+		}
+	}
+}
+
+// Deletes chat messages which are too old, or beyond the most which are kept
+func (h *Hub) pruneChatLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		dbTx := h.NewDbTx()
+		if err := dbTx.Queries.DeleteChatMessagesBefore(dbTx.Ctx, time.Now().UTC().Add(-h.Rules().ChatRetention)); err != nil {
+			log.Printf("Error deleting old chat messages: %v", err)
+		}
+		if err := dbTx.Queries.DeleteOldestChatMessages(dbTx.Ctx, int64(h.Rules().ChatMaxMessages)); err != nil {
+			log.Printf("Error deleting the oldest chat messages: %v", err)
+		}
+	}
+}
+
+// Moves ejected spores and shot viruses along until friction or the arena's edge brings them to rest.
+// Ejected spores which hit a virus on the way are fed to it. Only the server moves them, so everyone is sent where
+// they are after every tick, otherwise clients would look for them in the wrong place.
+func (h *Hub) moveObjectsLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	delta := rate.Seconds()
+	for range ticker.C {
+		rate = FollowInterval(ticker, rate, h.Rules().TickInterval)
+		delta = rate.Seconds()
+		friction := math.Exp(-h.Rules().EjectFriction * delta)
+
+		movedSpores := make(map[uint64]*objects.Spore)
+		h.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+			if spore.VelX == 0 && spore.VelY == 0 {
+				return
+			}
+			spore.X, spore.Y, spore.VelX, spore.VelY = h.slide(spore.X, spore.Y, spore.Radius, spore.VelX, spore.VelY, delta, friction)
+			movedSpores[sporeId] = spore
+
+			h.SharedGameObjects.Viruses.ForEach(func(virusId uint64, virus *objects.Virus) {
+				if _, exists := h.SharedGameObjects.Spores.Get(sporeId); !exists {
+					return
+				}
+				dx := spore.X - virus.X
+				dy := spore.Y - virus.Y
+				if dx*dx+dy*dy < virus.Radius*virus.Radius {
+					delete(movedSpores, sporeId)
+					h.feedVirus(virusId, virus, sporeId, spore)
+				}
+			})
+		})
+		if len(movedSpores) > 0 {
+			h.BroadcastChan <- &packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewSporesBatch(movedSpores),
+			}
+		}
+
+		h.SharedGameObjects.Viruses.ForEach(func(virusId uint64, virus *objects.Virus) {
+			if virus.VelX == 0 && virus.VelY == 0 {
+				return
+			}
+			virus.X, virus.Y, virus.VelX, virus.VelY = h.slide(virus.X, virus.Y, virus.Radius, virus.VelX, virus.VelY, delta, friction)
+			h.BroadcastChan <- &packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewVirus(virusId, virus),
+			}
+		})
+	}
+}
+
+// Moves a sliding object by one tick, returning its new position and velocity
+func (h *Hub) slide(x, y, radius, velX, velY, delta, friction float64) (float64, float64, float64, float64) {
+	movedX := x + velX*delta
+	movedY := y + velY*delta
+	newX, newY := h.Rules().Arena.Clamp(movedX, movedY, radius)
+	velX *= friction
+	velY *= friction
+
+	// Stop once it's too slow to matter, or it has hit the edge of the arena
+	hitEdge := newX != movedX || newY != movedY
+	if hitEdge || math.Hypot(velX, velY) < 1 {
+		velX, velY = 0, 0
+	}
+	return newX, newY, velX, velY
+}
+
+func (h *Hub) feedVirus(virusId uint64, virus *objects.Virus, sporeId uint64, spore *objects.Spore) {
+	// A player may have just consumed the spore
+	if !h.SharedGameObjects.Spores.Remove(sporeId) {
+		return
+	}
+	h.BroadcastChan <- &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewSporeConsumedByVirus(sporeId, virusId),
+	}
+
+	mass := objects.RadToMass(virus.Radius) + objects.RadToMass(spore.Radius)
+	if mass < h.Rules().VirusSplitMass {
+		virus.Radius = objects.MassToRad(mass)
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewVirus(virusId, virus),
+		}
+		return
+	}
+
+	// There are already as many viruses as there can be, the same as when they're spawned, so the virus takes the
+	// spore without splitting
+	if h.SharedGameObjects.Viruses.Len() >= h.Rules().MaxViruses {
+		return
+	}
+
+	// The virus has been fed enough, so it shrinks back and shoots off a new one in the direction it was fed
+	virus.Radius = h.Rules().VirusRadius
+	h.BroadcastChan <- &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewVirus(virusId, virus),
+	}
+
+	speed := math.Hypot(spore.VelX, spore.VelY)
+	dirX, dirY := 1.0, 0.0
+	if speed > 0 {
+		dirX, dirY = spore.VelX/speed, spore.VelY/speed
+	}
+	x, y := h.Rules().Arena.Clamp(virus.X+dirX*2*virus.Radius, virus.Y+dirY*2*virus.Radius, virus.Radius)
+	newVirus := &objects.Virus{
+		X:      x,
+		Y:      y,
+		Radius: h.Rules().VirusRadius,
+		VelX:   dirX * h.Rules().VirusShootSpeed,
+		VelY:   dirY * h.Rules().VirusShootSpeed,
+	}
+	newVirusId := h.SharedGameObjects.Viruses.Add(newVirus)
+	h.BroadcastChan <- &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewVirus(newVirusId, newVirus),
+	}
+}
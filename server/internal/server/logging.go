@@ -0,0 +1,61 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+)
+
+// How much the server logs
+type LogLevel int
+
+const (
+	// Everything, including each client's own log of its logins, state changes and messages
+	LogDebug LogLevel = iota
+	// Only what happens to the server as a whole
+	LogInfo
+	// Nothing at all
+	LogOff
+)
+
+func ParseLogLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return LogDebug, nil
+	case "info":
+		return LogInfo, nil
+	case "off":
+		return LogOff, nil
+	}
+	return LogDebug, fmt.Errorf("unknown log level %q", level)
+}
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogInfo:
+		return "info"
+	case LogOff:
+		return "off"
+	}
+	return "debug"
+}
+
+var clientLogsEnabled = true
+
+// Silences the logs below the level. Loggers which were already made keep writing where they did, so this should be
+// called before any clients connect.
+func SetLogLevel(level LogLevel) {
+	clientLogsEnabled = level == LogDebug
+	if level == LogOff {
+		log.SetOutput(io.Discard)
+	}
+}
+
+// Makes a logger for one client, which only logs at the debug level
+func NewClientLogger(prefix string) *log.Logger {
+	if !clientLogsEnabled {
+		return log.New(io.Discard, prefix, log.LstdFlags)
+	}
+	return log.New(log.Writer(), prefix, log.LstdFlags)
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"server/internal/server/auth"
+	"server/internal/server/db"
+	"strings"
+)
+
+// Columns added to tables after they were first released. The schema only creates tables which don't exist yet,
+// so databases from before these columns were added need them altered in.
+var columnMigrations = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{"users", "role", "TEXT NOT NULL DEFAULT 'player'"},
+	{"users", "muted_until", "DATETIME"},
+	{"users", "banned_until", "DATETIME"},
+	{"players", "name_changed_at", "DATETIME"},
+	{"players", "name_key", "TEXT NOT NULL DEFAULT ''"},
+	{"users", "last_address", "TEXT NOT NULL DEFAULT ''"},
+	{"users", "external_id", "TEXT"},
+}
+
+// Indexes on columns from above, which can only be created once the columns are there
+var indexMigrations = []string{
+	"CREATE INDEX IF NOT EXISTS users_last_address ON users (last_address)",
+	"CREATE UNIQUE INDEX IF NOT EXISTS users_external_id ON users (external_id)",
+}
+
+func (h *Hub) migrateColumns(ctx context.Context) error {
+	for _, migration := range columnMigrations {
+		var count int
+		row := h.dbPool.QueryRowContext(ctx, "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?", migration.table, migration.column)
+		if err := row.Scan(&count); err != nil {
+			return fmt.Errorf("error checking for column %s.%s: %w", migration.table, migration.column, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		log.Printf("Adding column %s.%s", migration.table, migration.column)
+		query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", migration.table, migration.column, migration.definition)
+		if _, err := h.dbPool.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("error adding column %s.%s: %w", migration.table, migration.column, err)
+		}
+	}
+	for _, query := range indexMigrations {
+		if _, err := h.dbPool.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("error creating index: %w", err)
+		}
+	}
+	return nil
+}
+
+// Players from before names were compared by their keys don't have one yet
+func (h *Hub) fillNameKeys(ctx context.Context) error {
+	queries := db.New(h.dbPool)
+	players, err := queries.ListPlayersWithoutNameKey(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting players without a name key: %w", err)
+	}
+	for _, player := range players {
+		err := queries.UpdatePlayerNameKey(ctx, db.UpdatePlayerNameKeyParams{
+			NameKey: auth.NameKey(player.Name),
+			ID:      player.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error filling in the name key of player %s: %w", player.Name, err)
+		}
+	}
+	if len(players) > 0 {
+		log.Printf("Filled in the name keys of %d players", len(players))
+	}
+	return nil
+}
+
+// Names were only checked for lookalikes before saving, so two players registering at once could both get the same
+// name key. The later players are renamed so the keys can be made unique, which stops that from happening again.
+func (h *Hub) uniqueNameKeys(ctx context.Context) error {
+	var indexes int
+	row := h.dbPool.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'index' AND name = 'players_name_key'")
+	if err := row.Scan(&indexes); err != nil {
+		return fmt.Errorf("error checking for the name key index: %w", err)
+	}
+	if indexes > 0 {
+		return nil
+	}
+
+	rows, err := h.dbPool.QueryContext(ctx, "SELECT id, name, name_key FROM players ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("error getting the name keys of players: %w", err)
+	}
+	type namedPlayer struct {
+		id      int64
+		name    string
+		nameKey string
+	}
+	players := make([]namedPlayer, 0)
+	taken := make(map[string]bool)
+	for rows.Next() {
+		var player namedPlayer
+		if err := rows.Scan(&player.id, &player.name, &player.nameKey); err != nil {
+			rows.Close()
+			return fmt.Errorf("error getting the name keys of players: %w", err)
+		}
+		players = append(players, player)
+		taken[player.nameKey] = true
+		taken[strings.ToLower(player.name)] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error getting the name keys of players: %w", err)
+	}
+
+	first := make(map[string]bool, len(players))
+	for _, player := range players {
+		if !first[player.nameKey] {
+			first[player.nameKey] = true
+			continue
+		}
+
+		// Numbers are kept by name keys, so adding one is enough to tell the names apart
+		var name, nameKey string
+		for n := 2; ; n++ {
+			name = fmt.Sprintf("%s%d", player.name, n)
+			nameKey = auth.NameKey(name)
+			if !taken[nameKey] && !taken[strings.ToLower(name)] {
+				break
+			}
+		}
+		_, err := h.dbPool.ExecContext(ctx, "UPDATE players SET name = ?, name_key = ? WHERE id = ?", name, nameKey, player.id)
+		if err != nil {
+			return fmt.Errorf("error renaming player %s: %w", player.name, err)
+		}
+		taken[nameKey] = true
+		taken[strings.ToLower(name)] = true
+		log.Printf("Renamed player %s to %s, since their name looked just like another player's", player.name, name)
+	}
+
+	_, err = h.dbPool.ExecContext(ctx, "CREATE UNIQUE INDEX IF NOT EXISTS players_name_key ON players (name_key)")
+	if err != nil {
+		return fmt.Errorf("error making name keys unique: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package objects
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+)
+
+type ArenaShape int
+
+const (
+	ArenaRectangle ArenaShape = iota
+	ArenaCircle
+)
+
+func ParseArenaShape(shape string) (ArenaShape, error) {
+	switch strings.ToLower(shape) {
+	case "rectangle":
+		return ArenaRectangle, nil
+	case "circle":
+		return ArenaCircle, nil
+	}
+	return ArenaRectangle, fmt.Errorf("unknown arena shape %q", shape)
+}
+
+func (s ArenaShape) String() string {
+	if s == ArenaCircle {
+		return "circle"
+	}
+	return "rectangle"
+}
+
+// The playable area of the world, centered on the origin
+type Arena struct {
+	Shape ArenaShape `json:"shape"`
+
+	// Only used by rectangular arenas
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+
+	// Only used by circular arenas
+	Radius float64 `json:"radius"`
+}
+
+// Moves a circle at the given position the shortest distance needed for it to be entirely inside the arena
+func (a *Arena) Clamp(x, y, radius float64) (float64, float64) {
+	switch a.Shape {
+	case ArenaCircle:
+		maxDist := max(a.Radius-radius, 0)
+		dist := math.Hypot(x, y)
+		if dist <= maxDist {
+			return x, y
+		}
+		return x / dist * maxDist, y / dist * maxDist
+	default:
+		halfWidth := max(a.Width/2-radius, 0)
+		halfHeight := max(a.Height/2-radius, 0)
+		return min(max(x, -halfWidth), halfWidth), min(max(y, -halfHeight), halfHeight)
+	}
+}
+
+// Picks a uniformly random position where a circle of the given radius fits entirely inside the arena
+func (a *Arena) RandomPoint(radius float64) (float64, float64) {
+	switch a.Shape {
+	case ArenaCircle:
+		dist := max(a.Radius-radius, 0) * math.Sqrt(rand.Float64())
+		angle := 2 * math.Pi * rand.Float64()
+		return dist * math.Cos(angle), dist * math.Sin(angle)
+	default:
+		halfWidth := max(a.Width/2-radius, 0)
+		halfHeight := max(a.Height/2-radius, 0)
+		return halfWidth * (2*rand.Float64() - 1), halfHeight * (2*rand.Float64() - 1)
+	}
+}
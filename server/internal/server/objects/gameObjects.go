@@ -1,17 +1,77 @@
 package objects
 
-import "time"
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// A single circle controlled by a player. Players start with one cell and can split into more.
+type Cell struct {
+	X      float64
+	Y      float64
+	Radius float64
+
+	// Extra velocity from being split off, which wears off over time
+	BoostX float64
+	BoostY float64
+
+	// The cell can't overlap or merge with the player's other cells before this time
+	MergeAt time.Time
+}
+
+type PowerUpKind int
+
+const (
+	PowerUpSpeedBoost PowerUpKind = iota
+	PowerUpShield
+	PowerUpMagnet
+
+	// Not a real power-up, just the number of kinds there are
+	numPowerUpKinds
+)
+
+// A pickup which grants the player who collects it a timed effect
+type PowerUp struct {
+	X      float64
+	Y      float64
+	Radius float64
+	Kind   PowerUpKind
+}
 
 type Player struct {
 	Name      string
-	X         float64
-	Y         float64
-	Radius    float64
+	Cells     *SharedCollection[*Cell]
 	Direction float64
 	Speed     float64
 	DbId      int64
 	BestScore int64
 	Color     int32
+	Role      string
+
+	// The player can't chat until this time
+	MutedUntil time.Time
+
+	// How many chat messages with banned words the player has sent this session
+	ChatOffences int
+
+	// When the player last reported another player
+	LastReportAt time.Time
+
+	// Set while one of the player's passwords is waiting on a login worker, so they can only queue one at a time
+	AwaitingLoginWorker bool
+
+	// Players whose chat is hidden from this player, keyed by their database ID
+	Ignored *SharedCollection[string]
+
+	// The team the player is playing for in team games, 0 otherwise
+	Team int
+
+	// The party the player is playing with, if any
+	Party *Party
+
+	// When each power-up effect on the player wears off
+	effectsUntil [numPowerUpKinds]time.Time
 }
 
 type Spore struct {
@@ -20,4 +80,74 @@ type Spore struct {
 	Radius    float64
 	DroppedBy *Player
 	DroppedAt time.Time
+
+	// Ejected spores slide for a while before coming to rest
+	VelX float64
+	VelY float64
+}
+
+// A hazard which pops cells big enough to cover it, and splits in two when fed enough
+type Virus struct {
+	X      float64
+	Y      float64
+	Radius float64
+
+	// Viruses shot off by a splitting virus slide for a while before coming to rest
+	VelX float64
+	VelY float64
+}
+
+// Guests play without registering, so they have no player in the database and nothing about them is saved
+func (p *Player) IsGuest() bool {
+	return p.DbId == 0
+}
+
+func (p *Player) GrantEffect(kind PowerUpKind, duration time.Duration) {
+	p.effectsUntil[kind] = time.Now().Add(duration)
+}
+
+func (p *Player) HasEffect(kind PowerUpKind) bool {
+	return time.Now().Before(p.effectsUntil[kind])
+}
+
+func RandomPowerUpKind() PowerUpKind {
+	return PowerUpKind(rand.IntN(int(numPowerUpKinds)))
+}
+
+func RadToMass(radius float64) float64 {
+	return math.Pi * radius * radius
+}
+
+func MassToRad(mass float64) float64 {
+	return math.Sqrt(mass / math.Pi)
+}
+
+// The combined mass of all the player's cells
+func (p *Player) Mass() float64 {
+	mass := 0.0
+	if p.Cells == nil {
+		return mass
+	}
+	p.Cells.ForEach(func(_ uint64, cell *Cell) {
+		mass += RadToMass(cell.Radius)
+	})
+	return mass
+}
+
+// The mass-weighted center of all the player's cells
+func (p *Player) Center() (float64, float64) {
+	var x, y, mass float64
+	if p.Cells == nil {
+		return x, y
+	}
+	p.Cells.ForEach(func(_ uint64, cell *Cell) {
+		cellMass := RadToMass(cell.Radius)
+		x += cell.X * cellMass
+		y += cell.Y * cellMass
+		mass += cellMass
+	})
+	if mass == 0 {
+		return 0, 0
+	}
+	return x / mass, y / mass
 }
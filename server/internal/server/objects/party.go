@@ -0,0 +1,50 @@
+package objects
+
+import "sync"
+
+// A group of players who play together. Members are keyed by their client ID rather than their player, since
+// players are replaced every time they respawn but stay in the party.
+type Party struct {
+	// The names of the members, keyed by their client ID
+	Members *SharedCollection[string]
+
+	// The names of the players invited to join who haven't yet, keyed by their client ID
+	Invited *SharedCollection[string]
+
+	leaderId uint64
+	mux      sync.Mutex
+}
+
+func NewParty(leaderId uint64, leaderName string) *Party {
+	p := &Party{
+		Members:  NewSharedCollection[string](),
+		Invited:  NewSharedCollection[string](),
+		leaderId: leaderId,
+	}
+	p.Members.Add(leaderName, leaderId)
+	return p
+}
+
+func (p *Party) LeaderId() uint64 {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.leaderId
+}
+
+// Takes the member out of the party, handing the lead to someone else if they had it.
+// Reports whether anyone is left in the party.
+func (p *Party) Leave(clientId uint64) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.Members.Remove(clientId)
+	if p.leaderId == clientId {
+		p.leaderId = 0
+		p.Members.ForEach(func(memberId uint64, _ string) {
+			if p.leaderId == 0 || memberId < p.leaderId {
+				p.leaderId = memberId
+			}
+		})
+	}
+	return p.leaderId != 0
+}
@@ -40,11 +40,14 @@ func (s *SharedCollection[T]) Add(obj T, id ...uint64) uint64 {
 }
 
 // Remove removes an object from the map by ID, if it exists.
-func (s *SharedCollection[T]) Remove(id uint64) {
+// Returns whether it existed, so whoever removes a contested object knows if they got it first.
+func (s *SharedCollection[T]) Remove(id uint64) bool {
 	s.mapMux.Lock()
 	defer s.mapMux.Unlock()
 
+	_, exists := s.objectsMap[id]
 	delete(s.objectsMap, id)
+	return exists
 }
 
 // Call the callback function for each object in the map.
@@ -1,11 +1,12 @@
 package objects
 
 import (
+	"math"
 	"math/rand/v2"
 )
 
-var getPlayerPosition = func(p *Player) (float64, float64) { return p.X, p.Y }
-var getPlayerRadius = func(p *Player) float64 { return p.Radius }
+var getCellPosition = func(c *Cell) (float64, float64) { return c.X, c.Y }
+var getCellRadius = func(c *Cell) float64 { return c.Radius }
 var getSporePosition = func(s *Spore) (float64, float64) { return s.X, s.Y }
 var getSporeRadius = func(s *Spore) float64 { return s.Radius }
 
@@ -37,24 +38,53 @@ func isTooClose[T any](x float64, y float64, radius float64, objects *SharedColl
 	return tooClose
 }
 
-func SpawnCoords(radius float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
-	var bound float64 = 3000
-	const maxTries int = 25
+func isTooCloseToPlayers(x float64, y float64, radius float64, players *SharedCollection[*Player]) bool {
+	if players == nil {
+		return false
+	}
 
-	tries := 0
-	for {
-		x := bound * (2*rand.Float64() - 1)
-		y := bound * (2*rand.Float64() - 1)
+	// Players can be spread over many cells, so check each of them
+	tooClose := false
+	players.ForEach(func(_ uint64, player *Player) {
+		if tooClose {
+			return
+		}
+		tooClose = isTooClose(x, y, radius, player.Cells, getCellPosition, getCellRadius)
+	})
 
-		if !isTooClose(x, y, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) &&
+	return tooClose
+}
+
+// Looks for a free spot in the arena, giving up after the given number of tries
+func SpawnCoords(arena *Arena, radius float64, maxTries int, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
+	var x, y float64
+	for tries := 0; tries < maxTries; tries++ {
+		x, y = arena.RandomPoint(radius)
+
+		if !isTooCloseToPlayers(x, y, radius, playersToAvoid) &&
 			!isTooClose(x, y, radius, sporesToAvoid, getSporePosition, getSporeRadius) {
 			return x, y
 		}
+	}
+
+	// The arena is too crowded to find a free spot, so settle for the last one we tried
+	return x, y
+}
+
+// Like SpawnCoords, but looks for a free spot close to the given position first
+func SpawnCoordsNear(arena *Arena, radius float64, maxTries int, x float64, y float64, playersToAvoid *SharedCollection[*Player]) (float64, float64) {
+	const maxDistance float64 = 300
 
-		tries++
-		if tries > maxTries {
-			bound *= 2
-			tries = 0
+	for tries := 0; tries < maxTries; tries++ {
+		angle := 2 * math.Pi * rand.Float64()
+		distance := maxDistance * math.Sqrt(rand.Float64())
+		nearX, nearY := arena.Clamp(x+distance*math.Cos(angle), y+distance*math.Sin(angle), radius)
+
+		if !isTooCloseToPlayers(nearX, nearY, radius, playersToAvoid) {
+			return nearX, nearY
 		}
 	}
+
+	// Too crowded around the position, so spawn somewhere else instead
+	return SpawnCoords(arena, radius, maxTries, playersToAvoid, nil)
 }
@@ -0,0 +1,33 @@
+package objects
+
+// Team colors are RGBA, the same as the colors players pick when registering
+var teamColors = []uint32{
+	0xE53935FF,
+	0x1E88E5FF,
+	0x43A047FF,
+	0xFDD835FF,
+	0x8E24AAFF,
+	0xFB8C00FF,
+}
+
+var teamNames = []string{"Red", "Blue", "Green", "Yellow", "Purple", "Orange"}
+
+// The most teams a game can be split into, one for each team color
+var MaxTeams = len(teamColors)
+
+// Teams are numbered from 1, since 0 means the player isn't in a team
+func TeamColor(team int) int32 {
+	return int32(teamColors[team-1])
+}
+
+func TeamName(team int) string {
+	return teamNames[team-1]
+}
+
+// The color the player is shown in, which is their team's color when they're in one
+func (p *Player) DisplayColor() int32 {
+	if p.Team != 0 {
+		return TeamColor(p.Team)
+	}
+	return p.Color
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+)
+
+// Takes our own player out of their party, if they're in one, and lets everyone left know. Only to be called from
+// the player's own client's goroutine, since it's the only one which can change the player.
+func LeaveParty(clients *objects.SharedCollection[ClientInterfacer], clientId uint64, player *objects.Player) {
+	party := player.Party
+	if party == nil {
+		return
+	}
+	player.Party = nil
+	if client, exists := clients.Get(clientId); exists {
+		client.SocketSend(packets.NewParty("", nil))
+	}
+	if party.Leave(clientId) {
+		SyncParty(clients, party)
+	}
+}
+
+// Takes someone else out of the party, like when they're kicked, and lets everyone left know. Their player belongs to
+// their own client, so it's left to that to forget the party, if it's still running and they haven't rejoined since.
+func RemoveFromParty(clients *objects.SharedCollection[ClientInterfacer], clientId uint64, party *objects.Party) {
+	if party.Leave(clientId) {
+		SyncParty(clients, party)
+	}
+	client, exists := clients.Get(clientId)
+	if !exists {
+		return
+	}
+	client.Enqueue(func() {
+		_, rejoined := party.Members.Get(clientId)
+		if player, online := client.OnlinePlayers().Get(clientId); online && player.Party == party && !rejoined {
+			player.Party = nil
+			client.SocketSend(packets.NewParty("", nil))
+		}
+	})
+}
+
+// Sends everyone in the party its current leader and members
+func SyncParty(clients *objects.SharedCollection[ClientInterfacer], party *objects.Party) {
+	leader, _ := party.Members.Get(party.LeaderId())
+	members := make([]string, 0, party.Members.Len())
+	party.Members.ForEach(func(_ uint64, name string) {
+		members = append(members, name)
+	})
+	slices.Sort(members)
+
+	party.Members.ForEach(func(memberId uint64, _ string) {
+		if client, exists := clients.Get(memberId); exists {
+			client.SocketSend(packets.NewParty(leader, members))
+		}
+	})
+}
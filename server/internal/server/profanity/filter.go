@@ -0,0 +1,350 @@
+package profanity
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// What happens to chat messages containing banned words
+type Policy int
+
+const (
+	// The banned words are replaced with asterisks
+	PolicyMask Policy = iota
+	// The message isn't sent at all
+	PolicyReject
+	// The banned words are masked, and players who keep using them are muted
+	PolicyMute
+)
+
+func ParsePolicy(policy string) (Policy, error) {
+	switch strings.ToLower(policy) {
+	case "mask":
+		return PolicyMask, nil
+	case "reject":
+		return PolicyReject, nil
+	case "mute":
+		return PolicyMute, nil
+	}
+	return PolicyMask, fmt.Errorf("unknown profanity policy %q", policy)
+}
+
+func (p Policy) String() string {
+	switch p {
+	case PolicyReject:
+		return "reject"
+	case PolicyMute:
+		return "mute"
+	}
+	return "mask"
+}
+
+// Characters commonly swapped in for letters to sneak words past the filter
+var leetspeak = map[rune]rune{
+	'0': 'o',
+	'1': 'i',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'8': 'b',
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+	'+': 't',
+}
+
+// Names are checked for banned words hidden inside them, but only words this long, since shorter ones are
+// too often part of innocent names
+const minNameSubstringLength int = 5
+
+// What the filter does about players using banned words
+type Settings struct {
+	Policy Policy
+
+	// With the mute policy, players are muted for the mute duration once they've used banned words this many times
+	MuteAfter    int
+	MuteDuration time.Duration
+}
+
+// A list of banned words and phrases loaded from a file, one per line. Lines starting with # are ignored.
+// The list is kept in memory and can be reloaded when the file changes.
+type Filter struct {
+	// Swapped out whenever the configuration is reloaded, see SetSettings
+	settings atomic.Pointer[Settings]
+
+	path string
+
+	words    map[string]struct{}
+	squeezed map[string]struct{}
+	phrases  [][]string
+	modTime  time.Time
+
+	mux sync.RWMutex
+}
+
+// Creates a filter with the words in the file at the given path. If the file can't be read, nothing is filtered
+// until it can be.
+func NewFilter(path string) *Filter {
+	f := &Filter{
+		path:     path,
+		words:    make(map[string]struct{}),
+		squeezed: make(map[string]struct{}),
+	}
+	f.settings.Store(&Settings{Policy: PolicyMask, MuteAfter: 3, MuteDuration: 10 * time.Minute})
+	if err := f.Reload(); err != nil {
+		log.Printf("Error loading banned words, chat won't be filtered: %v", err)
+	}
+	return f
+}
+
+// The current settings. They can be reloaded at any time, so code which uses several of them together should get
+// them once.
+func (f *Filter) Settings() Settings {
+	return *f.settings.Load()
+}
+
+// Swaps in new settings for every message from then on
+func (f *Filter) SetSettings(settings Settings) {
+	f.settings.Store(&settings)
+}
+
+// Reads the banned words from the file again, keeping the current ones if it can't be read
+func (f *Filter) Reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	words := make(map[string]struct{})
+	squeezed := make(map[string]struct{})
+	phrases := make([][]string, 0)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tokens := normalizeAll(strings.Fields(line))
+		switch len(tokens) {
+		case 0:
+			continue
+		case 1:
+			words[tokens[0]] = struct{}{}
+			squeezed[squeeze(tokens[0])] = struct{}{}
+		default:
+			phrases = append(phrases, tokens)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.words = words
+	f.squeezed = squeezed
+	f.phrases = phrases
+	f.modTime = info.ModTime()
+	log.Printf("Loaded %d banned words and %d banned phrases from %s", len(words), len(phrases), f.path)
+	return nil
+}
+
+// Reloads the banned words whenever the file is changed, checking every interval
+func (f *Filter) Watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		info, err := os.Stat(f.path)
+		if err != nil {
+			continue
+		}
+
+		f.mux.RLock()
+		changed := !info.ModTime().Equal(f.modTime)
+		f.mux.RUnlock()
+
+		if changed {
+			if err := f.Reload(); err != nil {
+				log.Printf("Error reloading banned words: %v", err)
+			}
+		}
+	}
+}
+
+// Replaces every banned word and phrase in the message with asterisks, and reports whether there were any
+func (f *Filter) Censor(msg string) (string, bool) {
+	originalWords := strings.Fields(msg)
+	if len(originalWords) == 0 {
+		return msg, false
+	}
+
+	f.mux.RLock()
+	defer f.mux.RUnlock()
+
+	// Words which normalize to nothing, like emoticons, are dropped, so keep track of where the rest came from
+	normalized := make([]string, 0, len(originalWords))
+	positions := make([]int, 0, len(originalWords))
+	for i, word := range originalWords {
+		if n := normalize(word); n != "" {
+			normalized = append(normalized, n)
+			positions = append(positions, i)
+		}
+	}
+
+	banned := make([]bool, len(originalWords))
+	found := false
+	mark := func(from, to int) {
+		for _, position := range positions[from:to] {
+			banned[position] = true
+		}
+		found = true
+	}
+
+	for i, word := range normalized {
+		if f.isBannedWord(word) {
+			mark(i, i+1)
+		}
+
+		for _, phrase := range f.phrases {
+			if i+len(phrase) <= len(normalized) && slices.Equal(normalized[i:i+len(phrase)], phrase) {
+				mark(i, i+len(phrase))
+			}
+		}
+
+		// Words spelled out one letter at a time, like "b a d"
+		if utf8.RuneCountInString(word) == 1 && (i == 0 || utf8.RuneCountInString(normalized[i-1]) != 1) {
+			end := i
+			for end < len(normalized) && utf8.RuneCountInString(normalized[end]) == 1 {
+				end++
+			}
+			if end-i > 1 && f.isBannedWord(strings.Join(normalized[i:end], "")) {
+				mark(i, end)
+			}
+		}
+	}
+
+	if !found {
+		return msg, false
+	}
+
+	outputWords := make([]string, len(originalWords))
+	for i, word := range originalWords {
+		if banned[i] {
+			outputWords[i] = strings.Repeat("*", utf8.RuneCountInString(word))
+		} else {
+			outputWords[i] = word
+		}
+	}
+	return strings.Join(outputWords, " "), true
+}
+
+// Reports whether the name is free of banned words, including ones hidden inside it
+func (f *Filter) IsCleanName(name string) bool {
+	if _, found := f.Censor(name); found {
+		return false
+	}
+
+	f.mux.RLock()
+	defer f.mux.RUnlock()
+
+	joined := normalize(name)
+	for word := range f.words {
+		if utf8.RuneCountInString(word) >= minNameSubstringLength && strings.Contains(joined, word) {
+			return false
+		}
+	}
+	for _, phrase := range f.phrases {
+		if strings.Contains(joined, strings.Join(phrase, "")) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Filter) isBannedWord(word string) bool {
+	if _, banned := f.words[word]; banned {
+		return true
+	}
+
+	// Stretching a word out, like "baaad", is only treated as an attempt to dodge the filter for runs of three or
+	// more, since plenty of innocent words have double letters
+	if hasRun(word, 3) {
+		_, banned := f.squeezed[squeeze(word)]
+		return banned
+	}
+	return false
+}
+
+// Lower cases the word, undoes leetspeak, and drops everything which isn't a letter.
+// Exclamation marks at the end are punctuation rather than leetspeak, so they're dropped first.
+func normalize(word string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(strings.TrimRight(word, "!")) {
+		if replacement, isLeet := leetspeak[r]; isLeet {
+			r = replacement
+		}
+		if unicode.IsLetter(r) {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// Normalizes each of the words, leaving out any which end up empty
+func normalizeAll(words []string) []string {
+	normalized := make([]string, 0, len(words))
+	for _, word := range words {
+		if n := normalize(word); n != "" {
+			normalized = append(normalized, n)
+		}
+	}
+	return normalized
+}
+
+// Collapses every run of the same letter into one
+func squeeze(word string) string {
+	var builder strings.Builder
+	var previous rune
+	for i, r := range word {
+		if i == 0 || r != previous {
+			builder.WriteRune(r)
+		}
+		previous = r
+	}
+	return builder.String()
+}
+
+func hasRun(word string, length int) bool {
+	run := 0
+	var previous rune
+	for i, r := range word {
+		if i > 0 && r == previous {
+			run++
+		} else {
+			run = 1
+		}
+		if run >= length {
+			return true
+		}
+		previous = r
+	}
+	return false
+}
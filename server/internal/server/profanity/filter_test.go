@@ -0,0 +1,119 @@
+package profanity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestFilter(t *testing.T, lines string) *Filter {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "banned_words.txt")
+	if err := os.WriteFile(path, []byte(lines), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return NewFilter(path)
+}
+
+func TestCensor(t *testing.T) {
+	filter := newTestFilter(t, "# comment\nbad\nidiot\n\nnasty word\nvery bad thing\n")
+
+	tests := []struct {
+		name  string
+		msg   string
+		want  string
+		found bool
+	}{
+		{"clean", "good game everyone", "good game everyone", false},
+		{"empty", "", "", false},
+		{"word", "that was bad", "that was ***", true},
+		{"case", "that was BAD", "that was ***", true},
+		{"punctuation", "bad, really", "**** really", true},
+		{"exclamation marks", "bad!!!", "******", true},
+		{"leetspeak", "that was b4d", "that was ***", true},
+		{"leetspeak symbols", "b@d and 8@d", "*** and ***", true},
+		{"leetspeak exclamation marks", "!d!0t!", "******", true},
+		{"stretched", "baaaad", "******", true},
+		{"double letters aren't stretching", "baad", "baad", false},
+		{"spelled out", "you are b a d", "you are * * *", true},
+		{"spelled out with leetspeak", "b 4 d", "* * *", true},
+		{"inside another word", "badminton", "badminton", false},
+		{"phrase", "what a nasty word", "what a ***** ****", true},
+		{"phrase with leetspeak", "n4sty w0rd", "***** ****", true},
+		{"phrase across emoticons", "nasty :) word", "***** :) ****", true},
+		{"part of a phrase", "nasty weather", "nasty weather", false},
+		{"phrase in the wrong order", "word nasty", "word nasty", false},
+		{"phrase containing a word", "a very bad thing", "a **** *** *****", true},
+		{"comments aren't words", "comment", "comment", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, found := filter.Censor(test.msg)
+			if got != test.want || found != test.found {
+				t.Errorf("Censor(%q) = %q, %v, want %q, %v", test.msg, got, found, test.want, test.found)
+			}
+		})
+	}
+}
+
+func TestIsCleanName(t *testing.T) {
+	filter := newTestFilter(t, "bad\nvillain\nnasty word\n")
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"Alice", true},
+		{"Bad", false},
+		{"B4d", false},
+		{"Badminton", true},
+		{"Villain", false},
+		{"TheVillain42", false},
+		{"Th3_V1ll41n", false},
+		{"NastyWord", false},
+		{"Nasty", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := filter.IsCleanName(test.name); got != test.want {
+				t.Errorf("IsCleanName(%q) = %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	filter := newTestFilter(t, "bad\n")
+	if err := os.WriteFile(filter.path, []byte("worse\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := filter.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if _, found := filter.Censor("bad"); found {
+		t.Error("Censor() still found a word taken out of the file")
+	}
+	if _, found := filter.Censor("worse"); !found {
+		t.Error("Censor() didn't find a word added to the file")
+	}
+
+	// The words are kept if the file goes missing
+	os.Remove(filter.path)
+	if err := filter.Reload(); err == nil {
+		t.Error("Reload() of a missing file succeeded")
+	}
+	if _, found := filter.Censor("worse"); !found {
+		t.Error("Censor() lost the words when the file couldn't be read")
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	for _, policy := range []Policy{PolicyMask, PolicyReject, PolicyMute} {
+		if parsed, err := ParsePolicy(policy.String()); err != nil || parsed != policy {
+			t.Errorf("ParsePolicy(%q) = %v, %v, want %v", policy.String(), parsed, err, policy)
+		}
+	}
+	if _, err := ParsePolicy("ignore"); err == nil {
+		t.Error("ParsePolicy(\"ignore\") succeeded")
+	}
+}
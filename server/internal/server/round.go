@@ -0,0 +1,265 @@
+package server
+
+import (
+	"database/sql"
+	"log"
+	"math"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// Numbered the same as the phases in the packets, so they can be sent as they are
+type RoundPhase int
+
+const (
+	// Waiting for enough players to join
+	RoundLobby RoundPhase = iota
+	// Enough players are here, the match is about to start
+	RoundCountdown
+	// The safe zone is shrinking and the last player standing wins
+	RoundMatch
+)
+
+type roundEntrant struct {
+	clientId uint64
+	player   *objects.Player
+}
+
+// A battle royale round. Players wait in the lobby until there are enough of them, then after a countdown they
+// fight it out in a shrinking safe zone until only one remains, after which everyone goes back to the lobby.
+type Round struct {
+	hub *Hub
+
+	phase          RoundPhase
+	phaseStartedAt time.Time
+	dbId           int64
+
+	// Players knocked out of the current match, in the order they went
+	eliminated []roundEntrant
+
+	mux sync.Mutex
+}
+
+func newRound(hub *Hub) *Round {
+	return &Round{
+		hub:            hub,
+		phase:          RoundLobby,
+		phaseStartedAt: time.Now(),
+	}
+}
+
+func (r *Round) Phase() RoundPhase {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.phase
+}
+
+// The radius of the safe zone around the center of the arena. Outside of a match, the whole arena is safe.
+func (r *Round) SafeZoneRadius() float64 {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.safeZoneRadius()
+}
+
+func (r *Round) safeZoneRadius() float64 {
+	rules := r.hub.Rules()
+	startRadius := rules.Arena.Radius
+	if rules.Arena.Shape == objects.ArenaRectangle {
+		startRadius = math.Hypot(rules.Arena.Width/2, rules.Arena.Height/2)
+	}
+	if r.phase != RoundMatch {
+		return startRadius
+	}
+
+	progress := min(float64(time.Since(r.phaseStartedAt))/float64(rules.RoundShrinkDuration), 1)
+	return startRadius + (rules.RoundFinalZoneRadius-startRadius)*progress
+}
+
+// Records the player as knocked out, if a match is being played
+func (r *Round) Eliminate(clientId uint64, player *objects.Player) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.phase != RoundMatch {
+		return
+	}
+	for _, entrant := range r.eliminated {
+		if entrant.player == player {
+			return
+		}
+	}
+	r.eliminated = append(r.eliminated, roundEntrant{clientId, player})
+}
+
+// A message describing the current phase, for clients who just joined
+func (r *Round) PhaseMessage() packets.Msg {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.phaseMessage()
+}
+
+func (r *Round) phaseMessage() packets.Msg {
+	rules := r.hub.Rules()
+	var remaining time.Duration
+	switch r.phase {
+	case RoundCountdown:
+		remaining = rules.RoundCountdown - time.Since(r.phaseStartedAt)
+	case RoundMatch:
+		remaining = rules.RoundShrinkDuration - time.Since(r.phaseStartedAt)
+	}
+
+	return packets.NewRoundPhase(
+		packets.RoundPhase(r.phase),
+		max(remaining, 0),
+		rules.RoundMinPlayers,
+		r.safeZoneRadius(),
+		rules.RoundFinalZoneRadius,
+	)
+}
+
+func (r *Round) run(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Messages are only sent once the lock is released, since clients check the round while handling them
+		for _, msg := range r.update() {
+			r.hub.BroadcastChan <- &packets.Packet{SenderId: 0, Msg: msg}
+		}
+	}
+}
+
+// Moves the round on to its next phase when it's time, returning the messages to let everyone know
+func (r *Round) update() []packets.Msg {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	rules := r.hub.Rules()
+	alive := r.alivePlayers()
+
+	switch r.phase {
+	case RoundLobby:
+		if len(alive) >= rules.RoundMinPlayers {
+			return []packets.Msg{r.setPhase(RoundCountdown)}
+		}
+	case RoundCountdown:
+		if len(alive) < rules.RoundMinPlayers {
+			return []packets.Msg{r.setPhase(RoundLobby)}
+		}
+		if time.Since(r.phaseStartedAt) >= rules.RoundCountdown {
+			return []packets.Msg{r.startMatch()}
+		}
+	case RoundMatch:
+		if len(alive) <= 1 {
+			return r.finishMatch(alive)
+		}
+	}
+	return nil
+}
+
+func (r *Round) setPhase(phase RoundPhase) packets.Msg {
+	r.phase = phase
+	r.phaseStartedAt = time.Now()
+	return r.phaseMessage()
+}
+
+func (r *Round) alivePlayers() []roundEntrant {
+	alive := make([]roundEntrant, 0)
+	r.hub.SharedGameObjects.Players.ForEach(func(clientId uint64, player *objects.Player) {
+		if player.Cells != nil && player.Cells.Len() > 0 {
+			alive = append(alive, roundEntrant{clientId, player})
+		}
+	})
+	return alive
+}
+
+func (r *Round) startMatch() packets.Msg {
+	r.eliminated = nil
+	r.dbId = 0
+
+	dbTx := r.hub.NewDbTx()
+	round, err := dbTx.Queries.CreateRound(dbTx.Ctx)
+	if err != nil {
+		log.Printf("Error creating round, its results won't be saved: %v", err)
+	} else {
+		r.dbId = round.ID
+	}
+
+	log.Printf("Starting round %d", r.dbId)
+	return r.setPhase(RoundMatch)
+}
+
+func (r *Round) finishMatch(alive []roundEntrant) []packets.Msg {
+	// Whoever is left standing places first, followed by everyone else in the reverse order they were knocked out.
+	// If nobody is left, the last one to go wins.
+	standings := make([]roundEntrant, 0, len(alive)+len(r.eliminated))
+	standings = append(standings, alive...)
+	for i := len(r.eliminated) - 1; i >= 0; i-- {
+		standings = append(standings, r.eliminated[i])
+	}
+
+	var winner roundEntrant
+	placements := make([]*packets.RoundPlacementMessage, 0, len(standings))
+	for i, entrant := range standings {
+		if i == 0 {
+			winner = entrant
+		}
+		placements = append(placements, &packets.RoundPlacementMessage{
+			Placement: uint32(i + 1),
+			PlayerId:  entrant.clientId,
+			Name:      entrant.player.Name,
+		})
+	}
+
+	r.saveResults(standings)
+
+	winnerName := ""
+	if winner.player != nil {
+		winnerName = winner.player.Name
+	}
+	log.Printf("Round %d won by %s", r.dbId, winnerName)
+
+	return []packets.Msg{
+		packets.NewRoundResult(winner.clientId, winnerName, placements),
+		r.setPhase(RoundLobby),
+	}
+}
+
+func (r *Round) saveResults(standings []roundEntrant) {
+	if r.dbId == 0 {
+		return
+	}
+
+	dbTx := r.hub.NewDbTx()
+	var winnerDbId sql.NullInt64
+	if len(standings) > 0 && !standings[0].player.IsGuest() {
+		winnerDbId = sql.NullInt64{Int64: standings[0].player.DbId, Valid: true}
+	}
+	err := dbTx.Queries.FinishRound(dbTx.Ctx, db.FinishRoundParams{
+		WinnerPlayerID: winnerDbId,
+		ID:             r.dbId,
+	})
+	if err != nil {
+		log.Printf("Error finishing round %d: %v", r.dbId, err)
+	}
+
+	for i, entrant := range standings {
+		if entrant.player.IsGuest() {
+			continue
+		}
+		err := dbTx.Queries.CreateRoundResult(dbTx.Ctx, db.CreateRoundResultParams{
+			RoundID:   r.dbId,
+			PlayerID:  entrant.player.DbId,
+			Placement: int64(i + 1),
+		})
+		if err != nil {
+			log.Printf("Error saving result of %s in round %d: %v", entrant.player.Name, r.dbId, err)
+		}
+	}
+}
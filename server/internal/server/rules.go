@@ -0,0 +1,436 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"server/internal/server/objects"
+	"slices"
+	"strings"
+	"time"
+)
+
+type GameMode int
+
+const (
+	ModeFreeForAll GameMode = iota
+	ModeTeams
+	ModeRoyale
+)
+
+func ParseGameMode(mode string) (GameMode, error) {
+	switch strings.ToLower(mode) {
+	case "ffa":
+		return ModeFreeForAll, nil
+	case "teams":
+		return ModeTeams, nil
+	case "royale":
+		return ModeRoyale, nil
+	}
+	return ModeFreeForAll, fmt.Errorf("unknown game mode %q", mode)
+}
+
+func (m GameMode) String() string {
+	switch m {
+	case ModeTeams:
+		return "teams"
+	case ModeRoyale:
+		return "royale"
+	}
+	return "ffa"
+}
+
+// Gameplay settings which can be tuned without touching the code
+type Rules struct {
+	Mode GameMode `json:"mode"`
+
+	// How many teams players are split into in team games
+	TeamCount int `json:"team_count"`
+
+	// How often the team scoreboard is sent out in team games
+	TeamScoreboardInterval time.Duration `json:"team_scoreboard_interval"`
+
+	// How often in-game players are sent the live leaderboard
+	LiveLeaderboardInterval time.Duration `json:"live_leaderboard_interval"`
+
+	// How many players a battle royale round needs before it can start
+	RoundMinPlayers int `json:"round_min_players"`
+
+	// How long the countdown before a battle royale match lasts
+	RoundCountdown time.Duration `json:"round_countdown"`
+
+	// How long the safe zone takes to shrink to its final size
+	RoundShrinkDuration time.Duration `json:"round_shrink_duration"`
+
+	// The size the safe zone shrinks down to
+	RoundFinalZoneRadius float64 `json:"round_final_zone_radius"`
+
+	// The fraction of their mass cells outside the safe zone lose per second
+	ZoneDamageRate float64 `json:"zone_damage_rate"`
+
+	// How many of the latest chat messages players are sent when they join
+	ChatReplayCount int `json:"chat_replay_count"`
+
+	// How long chat messages are kept for
+	ChatRetention time.Duration `json:"chat_retention"`
+
+	// The most chat messages kept, the oldest ones are deleted first
+	ChatMaxMessages int `json:"chat_max_messages"`
+
+	// The bounds of the world, nothing can leave it
+	Arena objects.Arena `json:"arena"`
+
+	// How often the world and the players' cells are moved along
+	TickInterval time.Duration `json:"tick_interval"`
+
+	// How many random spots are tried when looking for free space to spawn something in
+	SpawnTries int `json:"spawn_tries"`
+
+	// How long a consumed player has to wait before they can respawn
+	RespawnDelay time.Duration `json:"respawn_delay"`
+
+	// The size players start out at
+	StartRadius float64 `json:"start_radius"`
+
+	// The speed of cells at or below the speed reference mass
+	BaseSpeed float64 `json:"base_speed"`
+
+	// Cells heavier than this slow down according to the speed exponent
+	SpeedReferenceMass float64 `json:"speed_reference_mass"`
+
+	// How steeply speed drops off with mass, 0 means all cells move at the base speed
+	SpeedExponent float64 `json:"speed_exponent"`
+
+	// No cell gets slower than this, no matter how massive
+	MinSpeed float64 `json:"min_speed"`
+
+	// A cell has to be more than this many times the mass of another cell to consume it
+	ConsumeMassRatio float64 `json:"consume_mass_ratio"`
+
+	// How much further apart than touching a cell and what it consumes can be, to make up for lag
+	ConsumeTolerance float64 `json:"consume_tolerance"`
+
+	// Players heavier than this slowly lose mass
+	DecayThreshold float64 `json:"decay_threshold"`
+
+	// The fraction of a player's mass lost per second while above the decay threshold
+	DecayRate float64 `json:"decay_rate"`
+
+	// The most cells a single player can be split into
+	MaxCells int `json:"max_cells"`
+
+	// Cells lighter than this can't be split any further
+	MinSplitMass float64 `json:"min_split_mass"`
+
+	// The speed a freshly split cell is launched forward with
+	SplitSpeed float64 `json:"split_speed"`
+
+	// How long split cells have to stay apart before they can merge back together
+	MergeDelay time.Duration `json:"merge_delay"`
+
+	// The mass a cell loses for every spore it ejects
+	EjectMass float64 `json:"eject_mass"`
+
+	// Cells lighter than this can't eject any mass
+	MinEjectMass float64 `json:"min_eject_mass"`
+
+	// The speed ejected spores are fired at
+	EjectSpeed float64 `json:"eject_speed"`
+
+	// How quickly ejected spores slow down, as an exponential decay rate per second
+	EjectFriction float64 `json:"eject_friction"`
+
+	// How many spores the world is kept topped up with
+	MaxSpores int `json:"max_spores"`
+
+	// How often eaten spores are replaced
+	SporeReplenishInterval time.Duration `json:"spore_replenish_interval"`
+
+	// The most spores replaced at a time, so a big meal doesn't cause a lag spike
+	SporeReplenishBatch int `json:"spore_replenish_batch"`
+
+	// How many viruses the world is kept topped up with
+	MaxViruses int `json:"max_viruses"`
+
+	// How often popped viruses are replaced
+	VirusReplenishInterval time.Duration `json:"virus_replenish_interval"`
+
+	// The size of freshly spawned viruses
+	VirusRadius float64 `json:"virus_radius"`
+
+	// Once fed past this mass, a virus shrinks back and shoots off a new virus
+	VirusSplitMass float64 `json:"virus_split_mass"`
+
+	// The speed new viruses are shot off at
+	VirusShootSpeed float64 `json:"virus_shoot_speed"`
+
+	// The most cells a popped cell can burst into
+	VirusPopFragments int `json:"virus_pop_fragments"`
+
+	// How many power-ups can be lying around the world at once
+	MaxPowerUps int `json:"max_power_ups"`
+
+	// How often a new power-up is spawned while there are fewer than the max
+	PowerUpSpawnInterval time.Duration `json:"power_up_spawn_interval"`
+
+	// The size of power-up pickups
+	PowerUpRadius float64 `json:"power_up_radius"`
+
+	// How long the effect of a collected power-up lasts
+	PowerUpDuration time.Duration `json:"power_up_duration"`
+
+	// How much faster the speed boost power-up makes cells move
+	SpeedBoostMultiplier float64 `json:"speed_boost_multiplier"`
+
+	// How far away the magnet power-up attracts spores from
+	MagnetRange float64 `json:"magnet_range"`
+
+	// How fast spores are pulled in by the magnet power-up
+	MagnetSpeed float64 `json:"magnet_speed"`
+}
+
+func DefaultRules() *Rules {
+	return &Rules{
+		Mode:                    ModeFreeForAll,
+		TeamCount:               2,
+		TeamScoreboardInterval:  time.Second,
+		LiveLeaderboardInterval: 250 * time.Millisecond,
+		RoundMinPlayers:         2,
+		RoundCountdown:          10 * time.Second,
+		RoundShrinkDuration:     3 * time.Minute,
+		RoundFinalZoneRadius:    200,
+		ZoneDamageRate:          0.25,
+		ChatReplayCount:         20,
+		ChatRetention:           30 * 24 * time.Hour,
+		ChatMaxMessages:         100000,
+		Arena: objects.Arena{
+			Shape:  objects.ArenaRectangle,
+			Width:  6000,
+			Height: 6000,
+			Radius: 3000,
+		},
+		TickInterval:           50 * time.Millisecond,
+		SpawnTries:             25,
+		RespawnDelay:           3 * time.Second,
+		StartRadius:            20,
+		BaseSpeed:              150,
+		SpeedReferenceMass:     1250,
+		SpeedExponent:          0.3,
+		MinSpeed:               40,
+		ConsumeMassRatio:       1.5,
+		ConsumeTolerance:       10,
+		DecayThreshold:         10000,
+		DecayRate:              0.002,
+		MaxCells:               16,
+		MinSplitMass:           2500,
+		SplitSpeed:             600,
+		MergeDelay:             15 * time.Second,
+		EjectMass:              400,
+		MinEjectMass:           1600,
+		EjectSpeed:             500,
+		EjectFriction:          3,
+		MaxSpores:              1000,
+		SporeReplenishInterval: 2 * time.Second,
+		SporeReplenishBatch:    10,
+		MaxViruses:             20,
+		VirusReplenishInterval: 10 * time.Second,
+		VirusRadius:            50,
+		VirusSplitMass:         10500,
+		VirusShootSpeed:        700,
+		VirusPopFragments:      8,
+		MaxPowerUps:            10,
+		PowerUpSpawnInterval:   15 * time.Second,
+		PowerUpRadius:          15,
+		PowerUpDuration:        10 * time.Second,
+		SpeedBoostMultiplier:   1.5,
+		MagnetRange:            250,
+		MagnetSpeed:            400,
+	}
+}
+
+// The speed of a cell with the given mass, following the configured speed curve
+func (r *Rules) SpeedForMass(mass float64) float64 {
+	if mass <= r.SpeedReferenceMass {
+		return r.BaseSpeed
+	}
+	speed := r.BaseSpeed * math.Pow(r.SpeedReferenceMass/mass, r.SpeedExponent)
+	return max(speed, r.MinSpeed)
+}
+
+// Resets the ticker if the rules were reloaded with a different interval for it, returning the interval it's now on
+func FollowInterval(ticker *time.Ticker, current time.Duration, interval time.Duration) time.Duration {
+	if interval != current {
+		ticker.Reset(interval)
+	}
+	return interval
+}
+
+// Reads the rules from the JSON file at the given path, keeping the defaults for anything it leaves out. The file can
+// also have an "arenas" object of named sets of rules, and those of the named arena are applied on top of the rest.
+// Durations are written like "1.5s" and the mode and arena shape by name, as in the environment variables.
+func LoadRules(path string, arenaName string) (*Rules, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var settings map[string]json.RawMessage
+	if err := json.Unmarshal(contents, &settings); err != nil {
+		return nil, fmt.Errorf("malformed rules file: %w", err)
+	}
+
+	var arenas map[string]map[string]json.RawMessage
+	if raw, exists := settings["arenas"]; exists {
+		if err := json.Unmarshal(raw, &arenas); err != nil {
+			return nil, fmt.Errorf("malformed arenas: %w", err)
+		}
+		delete(settings, "arenas")
+	}
+
+	rules := DefaultRules()
+	if err := applySettings(reflect.ValueOf(rules).Elem(), settings); err != nil {
+		return nil, err
+	}
+	if arenaName != "" {
+		overrides, exists := arenas[arenaName]
+		if !exists {
+			return nil, fmt.Errorf("no arena called %q in the rules file", arenaName)
+		}
+		if err := applySettings(reflect.ValueOf(rules).Elem(), overrides); err != nil {
+			return nil, fmt.Errorf("arena %s: %w", arenaName, err)
+		}
+	}
+	return rules, nil
+}
+
+// Sets the fields of the struct named by the settings' keys, going by their JSON tags
+func applySettings(target reflect.Value, settings map[string]json.RawMessage) error {
+	fields := make(map[string]reflect.Value)
+	for i := range target.NumField() {
+		if name := target.Type().Field(i).Tag.Get("json"); name != "" {
+			fields[name] = target.Field(i)
+		}
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		field, exists := fields[key]
+		if !exists {
+			return fmt.Errorf("unknown setting %q", key)
+		}
+		if err := applySetting(field, settings[key]); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func applySetting(field reflect.Value, value json.RawMessage) error {
+	switch target := field.Addr().Interface().(type) {
+	case *time.Duration:
+		var duration string
+		if err := json.Unmarshal(value, &duration); err != nil {
+			return err
+		}
+		parsed, err := time.ParseDuration(duration)
+		if err != nil {
+			return err
+		}
+		*target = parsed
+	case *GameMode:
+		var mode string
+		if err := json.Unmarshal(value, &mode); err != nil {
+			return err
+		}
+		parsed, err := ParseGameMode(mode)
+		if err != nil {
+			return err
+		}
+		*target = parsed
+	case *objects.ArenaShape:
+		var shape string
+		if err := json.Unmarshal(value, &shape); err != nil {
+			return err
+		}
+		parsed, err := objects.ParseArenaShape(shape)
+		if err != nil {
+			return err
+		}
+		*target = parsed
+	case *objects.Arena:
+		// Arenas can be partly overridden, e.g. just their radius
+		var settings map[string]json.RawMessage
+		if err := json.Unmarshal(value, &settings); err != nil {
+			return err
+		}
+		return applySettings(field, settings)
+	default:
+		return json.Unmarshal(value, target)
+	}
+	return nil
+}
+
+// Checks the rules make sense together, returning everything wrong with them
+func (r *Rules) Validate() error {
+	var errs []error
+	check := func(valid bool, format string, args ...any) {
+		if !valid {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	// Nothing can be negative, and some things can't be zero either
+	value := reflect.ValueOf(r).Elem()
+	for i := range value.NumField() {
+		field := value.Field(i)
+		name := value.Type().Field(i).Tag.Get("json")
+		switch field.Kind() {
+		case reflect.Int, reflect.Int64:
+			check(field.Int() >= 0, "%s can't be negative", name)
+		case reflect.Float64:
+			check(field.Float() >= 0 && !math.IsInf(field.Float(), 0), "%s can't be negative", name)
+		}
+	}
+	intervals := []struct {
+		name     string
+		interval time.Duration
+	}{
+		{"tick_interval", r.TickInterval},
+		{"team_scoreboard_interval", r.TeamScoreboardInterval},
+		{"live_leaderboard_interval", r.LiveLeaderboardInterval},
+		{"spore_replenish_interval", r.SporeReplenishInterval},
+		{"virus_replenish_interval", r.VirusReplenishInterval},
+		{"power_up_spawn_interval", r.PowerUpSpawnInterval},
+		{"round_shrink_duration", r.RoundShrinkDuration},
+		{"chat_retention", r.ChatRetention},
+	}
+	for _, i := range intervals {
+		check(i.interval > 0, "%s must be longer than 0", i.name)
+	}
+
+	check(r.TeamCount >= 2 && r.TeamCount <= objects.MaxTeams, "team_count must be between 2 and %d", objects.MaxTeams)
+	switch r.Arena.Shape {
+	case objects.ArenaCircle:
+		check(r.Arena.Radius > r.StartRadius, "the arena radius must be bigger than start_radius")
+	default:
+		check(r.Arena.Width > 2*r.StartRadius && r.Arena.Height > 2*r.StartRadius, "the arena must be wider and taller than a starting player")
+	}
+	check(r.SpawnTries >= 1, "spawn_tries must be at least 1")
+	check(r.StartRadius > 0, "start_radius must be bigger than 0")
+	check(r.BaseSpeed > 0, "base_speed must be bigger than 0")
+	check(r.MinSpeed <= r.BaseSpeed, "min_speed can't be more than base_speed")
+	check(r.SpeedReferenceMass > 0, "speed_reference_mass must be bigger than 0")
+	check(r.ConsumeMassRatio >= 1, "consume_mass_ratio must be at least 1")
+	check(r.MaxCells >= 1, "max_cells must be at least 1")
+	check(r.SporeReplenishBatch >= 1, "spore_replenish_batch must be at least 1")
+	check(r.VirusRadius > 0, "virus_radius must be bigger than 0")
+	check(r.PowerUpRadius > 0, "power_up_radius must be bigger than 0")
+	check(r.RoundMinPlayers >= 1, "round_min_players must be at least 1")
+	return errors.Join(errs...)
+}
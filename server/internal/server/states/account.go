@@ -0,0 +1,303 @@
+package states
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/auth"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// How long players have to wait after changing their name before they can change it again
+const nameChangeCooldown = 7 * 24 * time.Hour
+
+// Handles the packets our own client can send from any of the logged in states, reporting whether it was one
+func handleLoggedInMessage(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, senderId uint64, message packets.Msg) bool {
+	return handleFriendMessage(client, logger, player, senderId, message) ||
+		handlePartyMessage(client, logger, player, senderId, message) ||
+		handleAccountMessage(client, logger, player, senderId, message)
+}
+
+func handleAccountMessage(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, senderId uint64, message packets.Msg) bool {
+	if senderId != client.Id() {
+		return false
+	}
+
+	switch message.(type) {
+	case *packets.Packet_ChangePassword, *packets.Packet_ChangeName, *packets.Packet_ChangeColor, *packets.Packet_DeleteAccount:
+		if player.IsGuest() {
+			client.SocketSend(packets.NewDenyResponse("Guests don't have an account - register to get one"))
+			return true
+		}
+	}
+
+	switch message := message.(type) {
+	case *packets.Packet_RegisterRequest:
+		registerGuest(client, logger, player, message.RegisterRequest)
+	case *packets.Packet_ChangePassword:
+		changePassword(client, logger, player, message.ChangePassword)
+	case *packets.Packet_ChangeName:
+		changeName(client, logger, player, message.ChangeName.Name)
+	case *packets.Packet_ChangeColor:
+		changeColor(client, logger, player, message.ChangeColor.Color)
+	case *packets.Packet_DeleteAccount:
+		deleteAccount(client, logger, player, message.DeleteAccount.Password)
+	default:
+		return false
+	}
+	return true
+}
+
+// Turns the guest into a registered player without leaving the game, keeping the best score they've set so far
+func registerGuest(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, request *packets.RegisterRequestMessage) {
+	if !player.IsGuest() {
+		client.SocketSend(packets.NewDenyResponse("You're already registered"))
+		return
+	}
+
+	registerUser(client, logger, awaitingLoginWorker(client, player), request, func(dbPlayer db.Player, mutedUntil time.Time) {
+		// They could have respawned since, in which case it's their new player who gets registered
+		if current, online := client.OnlinePlayers().Get(client.Id()); online && current.IsGuest() {
+			claimGuest(client, logger, current, dbPlayer, mutedUntil)
+		} else {
+			logger.Printf("Guest %s left before they could be registered as %s", player.Name, dbPlayer.Name)
+		}
+	})
+}
+
+// The flag marking the client's player as waiting on a login worker. Players are replaced when they respawn, taking
+// the flag with them, so it's looked up again each time instead of being kept.
+func awaitingLoginWorker(client server.ClientInterfacer, player *objects.Player) func() *bool {
+	return func() *bool {
+		if online, exists := client.OnlinePlayers().Get(client.Id()); exists {
+			return &online.AwaitingLoginWorker
+		}
+		return &player.AwaitingLoginWorker
+	}
+}
+
+// Gives the guest the newly registered player, saving the best score they'd set as a guest. The player was saved
+// muted until mutedUntil, and whichever of that and the guest's own mute ends later carries on.
+func claimGuest(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, dbPlayer db.Player, mutedUntil time.Time) {
+	if player.BestScore > 0 {
+		err := client.DbTx().Queries.UpdatePlayerBestScore(client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
+			BestScore: player.BestScore,
+			ID:        dbPlayer.ID,
+		})
+		if err != nil {
+			logger.Printf("Error saving best score of guest %s: %v", player.Name, err)
+		}
+	}
+
+	if player.MutedUntil.After(mutedUntil) && time.Now().Before(player.MutedUntil) {
+		err := client.DbTx().Queries.MutePlayer(client.DbTx().Ctx, db.MutePlayerParams{
+			MutedUntil: sql.NullTime{Time: player.MutedUntil, Valid: true},
+			ID:         dbPlayer.ID,
+		})
+		if err != nil {
+			logger.Printf("Error saving mute of guest %s: %v", player.Name, err)
+		}
+	} else {
+		player.MutedUntil = mutedUntil
+	}
+
+	logger.Printf("Guest %s registered as %s", player.Name, dbPlayer.Name)
+	player.DbId = dbPlayer.ID
+	player.Name = dbPlayer.Name
+	player.Color = int32(dbPlayer.Color)
+	if player.Party != nil {
+		player.Party.Members.Add(player.Name, client.Id())
+		server.SyncParty(client.Clients(), player.Party)
+	}
+	client.SocketSend(packets.NewOkResponse())
+}
+
+func changePassword(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, message *packets.ChangePasswordMessage) {
+	if !client.Authenticator().AllowsRegistration() {
+		client.SocketSend(packets.NewDenyResponse("Passwords can't be changed on this server"))
+		return
+	}
+	checkPassword(client, logger, player, message.OldPassword, func(user db.User) {
+		if err := client.AccountPolicy().CheckPassword(message.NewPassword, user.Username); err != nil {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid password: %v", err)))
+			return
+		}
+		runOnLoginWorker(client, logger, awaitingLoginWorker(client, player), func() func() {
+			passwordHash, err := client.PasswordHasher().Hash(message.NewPassword)
+			return func() { savePassword(client, logger, user, passwordHash, err) }
+		})
+	})
+}
+
+// Replaces the user's password hash with the new one, once it's been made
+func savePassword(client server.ClientInterfacer, logger *log.Logger, user db.User, passwordHash string, err error) {
+	if err != nil {
+		logger.Printf("Failed to hash password: %s", user.Username)
+		client.SocketSend(packets.NewDenyResponse("Failed to change your password - please try again later"))
+		return
+	}
+	err = client.DbTx().Queries.UpdateUserPassword(client.DbTx().Ctx, db.UpdateUserPasswordParams{
+		PasswordHash: passwordHash,
+		ID:           user.ID,
+	})
+	if err != nil {
+		logger.Printf("Error changing password of user %s: %v", user.Username, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to change your password - please try again later"))
+		return
+	}
+
+	logger.Printf("User %s changed their password", user.Username)
+	client.SocketSend(packets.NewOkResponse())
+}
+
+// Changes the name the player is shown as. The username they log in with stays the same.
+func changeName(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	if err := client.AccountPolicy().CheckUsername(name); err != nil {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid name: %v", err)))
+		return
+	}
+	if !client.ChatFilter().IsCleanName(name) {
+		client.SocketSend(packets.NewDenyResponse("Invalid name: contains banned words"))
+		return
+	}
+
+	dbTx := client.DbTx()
+	dbPlayer, err := dbTx.Queries.GetPlayerByID(dbTx.Ctx, player.DbId)
+	if err != nil {
+		logger.Printf("Error getting player %s: %v", player.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to change your name - please try again later"))
+		return
+	}
+	if dbPlayer.NameChangedAt.Valid {
+		if remaining := nameChangeCooldown - time.Since(dbPlayer.NameChangedAt.Time); remaining > 0 {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You can change your name again in %v", remaining.Round(time.Minute))))
+			return
+		}
+	}
+
+	// Changing the case of our own name is fine
+	nameKey := auth.NameKey(name)
+	if other, err := dbTx.Queries.GetPlayerByNameKey(dbTx.Ctx, nameKey); err == nil && other.ID != player.DbId {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Name too similar to the existing player %s", other.Name)))
+		return
+	}
+
+	err = dbTx.Queries.UpdatePlayerName(dbTx.Ctx, db.UpdatePlayerNameParams{
+		Name:    name,
+		NameKey: nameKey,
+		ID:      player.DbId,
+	})
+	if server.IsUniqueViolation(err) {
+		client.SocketSend(packets.NewDenyResponse("That name was just taken - please pick another"))
+		return
+	} else if err != nil {
+		logger.Printf("Error changing name of player %s to %s: %v", player.Name, name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to change your name - please try again later"))
+		return
+	}
+
+	logger.Printf("Player %s changed their name to %s", player.Name, name)
+	player.Name = name
+	if player.Party != nil {
+		player.Party.Members.Add(name, client.Id())
+		server.SyncParty(client.Clients(), player.Party)
+	}
+	client.SocketSend(packets.NewOkResponse())
+}
+
+func changeColor(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, color int32) {
+	err := client.DbTx().Queries.UpdatePlayerColor(client.DbTx().Ctx, db.UpdatePlayerColorParams{
+		Color: int64(color),
+		ID:    player.DbId,
+	})
+	if err != nil {
+		logger.Printf("Error changing color of player %s: %v", player.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to change your color - please try again later"))
+		return
+	}
+
+	player.Color = color
+	client.SocketSend(packets.NewOkResponse())
+}
+
+// Deletes the user and everything saved about them, then logs them out. Reports they made are kept, but no longer
+// say who made them.
+func deleteAccount(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, password string) {
+	checkPassword(client, logger, player, password, func(user db.User) {
+		deleteUser(client, logger, player, user)
+	})
+}
+
+func deleteUser(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, user db.User) {
+	ctx := client.DbTx().Ctx
+	playerId := sql.NullInt64{Int64: player.DbId, Valid: true}
+	err := client.DbTx().InTransaction(func(queries *db.Queries) error {
+		deletes := []func() error{
+			func() error { return queries.DeletePlayerFriends(ctx, player.DbId) },
+			func() error { return queries.DeletePlayerChatMessages(ctx, player.DbId) },
+			func() error { return queries.DeletePlayerReports(ctx, player.DbId) },
+			func() error { return queries.ClearReportsByReporter(ctx, playerId) },
+			func() error { return queries.DeletePlayerRoundResults(ctx, player.DbId) },
+			func() error { return queries.ClearRoundWinner(ctx, playerId) },
+			func() error { return queries.DeletePlayer(ctx, player.DbId) },
+			func() error { return queries.DeleteUser(ctx, user.ID) },
+		}
+		for _, deleteRows := range deletes {
+			if err := deleteRows(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Printf("Error deleting user %s: %v", user.Username, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to delete your account - please try again later"))
+		return
+	}
+
+	logger.Printf("User %s deleted their account", user.Username)
+	client.SocketSend(packets.NewOkResponse())
+
+	// Logged out like any other player, so nobody's left seeing a player who no longer exists
+	client.Broadcast(packets.NewDisconnect("deleted their account"))
+	server.LeaveParty(client.Clients(), client.Id(), player)
+	client.OnlinePlayers().Remove(client.Id())
+	client.SetState(&Connected{})
+}
+
+// Passes the player's user to checked if the password is theirs, otherwise lets the client know it's wrong. The
+// password is checked on a login worker, and checked is only called if the player is still logged in by then.
+func checkPassword(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, password string, checked func(db.User)) {
+	dbTx := client.DbTx()
+	dbPlayer, err := dbTx.Queries.GetPlayerByID(dbTx.Ctx, player.DbId)
+	if err != nil {
+		logger.Printf("Error getting player %s: %v", player.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to get your account - please try again later"))
+		return
+	}
+	user, err := dbTx.Queries.GetUserByID(dbTx.Ctx, dbPlayer.UserID)
+	if err != nil {
+		logger.Printf("Error getting user of player %s: %v", player.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to get your account - please try again later"))
+		return
+	}
+
+	runOnLoginWorker(client, logger, awaitingLoginWorker(client, player), func() func() {
+		identity, err := client.Authenticator().Authenticate(dbTx.Ctx, user.Username, password)
+		return func() {
+			if online, exists := client.OnlinePlayers().Get(client.Id()); !exists || online != player {
+				return
+			}
+			if err != nil || !identity.Matches(user) {
+				logger.Printf("User entered wrong password: %s", user.Username)
+				client.SocketSend(packets.NewDenyResponse("Incorrect password"))
+				return
+			}
+			checked(user)
+		}
+	})
+}
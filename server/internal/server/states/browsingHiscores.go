@@ -6,6 +6,7 @@ import (
 	"log"
 	"server/internal/server"
 	"server/internal/server/db"
+	"server/internal/server/objects"
 	"server/pkg/packets"
 )
 
@@ -14,6 +15,10 @@ type BrowsingHiscores struct {
 	logger  *log.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+
+	// Only set when a logged in player is browsing, who goes back to the state they came from when they're done
+	player   *objects.Player
+	returnTo server.ClientStateHandler
 }
 
 func (b *BrowsingHiscores) Name() string {
@@ -23,7 +28,7 @@ func (b *BrowsingHiscores) Name() string {
 func (b *BrowsingHiscores) SetClient(client server.ClientInterfacer) {
 	b.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), b.Name())
-	b.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	b.logger = server.NewClientLogger(loggingPrefix)
 	b.queries = client.DbTx().Queries
 	b.dbCtx = client.DbTx().Ctx
 }
@@ -38,6 +43,12 @@ func (b *BrowsingHiscores) HandlerMessage(senderId uint64, message packets.Msg)
 		b.handleFinishedBrowsingHiscores(senderId, message)
 	case *packets.Packet_SearchHiscore:
 		b.handleSearchHiscore(senderId, message)
+	case *packets.Packet_ReportPlayer:
+		b.handleReportPlayer(senderId, message)
+	default:
+		if b.player != nil {
+			handleLoggedInMessage(b.client, b.logger, b.player, senderId, message)
+		}
 	}
 }
 
@@ -45,6 +56,10 @@ func (b *BrowsingHiscores) OnExit() {
 }
 
 func (b *BrowsingHiscores) handleFinishedBrowsingHiscores(_ uint64, _ *packets.Packet_FinishedBrowsingHiscores) {
+	if b.returnTo != nil {
+		b.client.SetState(b.returnTo)
+		return
+	}
 	b.client.SetState(&Connected{})
 }
 
@@ -69,6 +84,14 @@ func (b *BrowsingHiscores) handleSearchHiscore(_ uint64, message *packets.Packet
 	b.sendTopScores(limit, max(0, offset))
 }
 
+// The hiscores can be browsed without logging in, but only registered players get to report from here
+func (b *BrowsingHiscores) handleReportPlayer(senderId uint64, message *packets.Packet_ReportPlayer) {
+	if senderId != b.client.Id() {
+		return
+	}
+	submitReport(b.client, b.logger, b.player, message)
+}
+
 func (b *BrowsingHiscores) sendTopScores(limit int64, offset int64) {
 	topScores, err := b.queries.GetTopScores(b.dbCtx, db.GetTopScoresParams{
 		Limit:  limit,
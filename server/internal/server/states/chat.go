@@ -0,0 +1,190 @@
+package states
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/auth"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/internal/server/profanity"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+// Sends a chat message from our own player to whoever should hear it on its channel, or runs it if it's a command.
+// Global messages reach every logged in player, arena messages only those playing in the arena right now.
+func sendChat(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, message *packets.Packet_Chat) {
+	if strings.HasPrefix(message.Chat.Msg, "/") {
+		runChatCommand(client, logger, player, message.Chat.Msg)
+		return
+	}
+	if remaining := time.Until(player.MutedUntil); remaining > 0 {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You're muted for another %v", remaining.Round(time.Second))))
+		return
+	}
+
+	text := message.Chat.Msg
+	if !filterChat(client, logger, player, message) {
+		return
+	}
+	message.Chat.SenderName = player.Name
+
+	switch message.Chat.Channel {
+	case packets.ChatChannel_CHAT_CHANNEL_TEAM:
+		if player.Team == 0 {
+			client.SocketSend(packets.NewDenyResponse("You're not in a team"))
+			return
+		}
+		client.OnlinePlayers().ForEach(func(playerId uint64, other *objects.Player) {
+			if playerId != client.Id() && other.Team == player.Team {
+				client.PassToPeer(message, playerId)
+			}
+		})
+	case packets.ChatChannel_CHAT_CHANNEL_PARTY:
+		if player.Party == nil {
+			client.SocketSend(packets.NewDenyResponse("You're not in a party"))
+			return
+		}
+		player.Party.Members.ForEach(func(memberId uint64, _ string) {
+			if memberId != client.Id() {
+				client.PassToPeer(message, memberId)
+			}
+		})
+	case packets.ChatChannel_CHAT_CHANNEL_WHISPER:
+		targetId, found := findOnlinePlayer(client, message.Chat.Target)
+		if !found {
+			client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s is not online", message.Chat.Target)))
+			return
+		}
+		// Passing it to ourselves would have us send it all over again
+		if targetId == client.Id() {
+			client.SocketSend(packets.NewDenyResponse("You can't whisper to yourself"))
+			return
+		}
+		client.PassToPeer(message, targetId)
+	case packets.ChatChannel_CHAT_CHANNEL_GLOBAL, packets.ChatChannel_CHAT_CHANNEL_ARENA:
+		client.Broadcast(message)
+	default:
+		// Announcements get past everyone's ignore list, so only /announce can make them
+		logger.Printf("%s tried to chat on channel %v", player.Name, message.Chat.Channel)
+		client.SocketSend(packets.NewDenyResponse("You can't chat on that channel"))
+		return
+	}
+
+	// The original text is kept for moderation, the filter is applied again whenever it's shown to players.
+	// Guests have no player to keep it under.
+	if player.IsGuest() {
+		return
+	}
+	err := client.DbTx().Queries.CreateChatMessage(client.DbTx().Ctx, db.CreateChatMessageParams{
+		PlayerID: player.DbId,
+		Channel:  int64(message.Chat.Channel),
+		Target:   message.Chat.Target,
+		Text:     text,
+		Filtered: text != message.Chat.Msg,
+	})
+	if err != nil {
+		logger.Printf("Error saving chat message from %s: %v", player.Name, err)
+	}
+}
+
+// Sends our client the latest messages from the public channels, oldest first
+func sendChatHistory(client server.ClientInterfacer, logger *log.Logger, count int) {
+	history, err := client.DbTx().Queries.GetRecentChatMessages(client.DbTx().Ctx, db.GetRecentChatMessagesParams{
+		Channel:   int64(packets.ChatChannel_CHAT_CHANNEL_GLOBAL),
+		Channel_2: int64(packets.ChatChannel_CHAT_CHANNEL_ARENA),
+		Limit:     int64(count),
+	})
+	if err != nil {
+		logger.Printf("Error getting chat history: %v", err)
+		return
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		entry := history[i]
+		text, _ := client.ChatFilter().Censor(entry.Text)
+		message := packets.NewChat(text).(*packets.Packet_Chat)
+		message.Chat.Channel = packets.ChatChannel(entry.Channel)
+		message.Chat.SenderName = entry.Name
+		message.Chat.Replayed = true
+		client.SocketSendAs(message, 0)
+	}
+}
+
+// Applies the chat filter's policy to a message we're about to send, reporting whether it can still be sent
+func filterChat(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, message *packets.Packet_Chat) bool {
+	filter := client.ChatFilter()
+	censored, found := filter.Censor(message.Chat.Msg)
+	if !found {
+		return true
+	}
+
+	settings := filter.Settings()
+	switch settings.Policy {
+	case profanity.PolicyReject:
+		client.SocketSend(packets.NewDenyResponse("Your message wasn't sent because it contains banned words"))
+		return false
+	case profanity.PolicyMute:
+		player.ChatOffences++
+		if player.ChatOffences >= settings.MuteAfter {
+			mutePlayer(client, logger, player, settings.MuteDuration)
+			player.ChatOffences = 0
+		}
+	}
+	message.Chat.Msg = censored
+	return true
+}
+
+// Stops our player from chatting for the given duration, including after logging back in
+func mutePlayer(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, duration time.Duration) {
+	player.MutedUntil = time.Now().Add(duration)
+	if !player.IsGuest() {
+		err := client.DbTx().Queries.MutePlayer(client.DbTx().Ctx, db.MutePlayerParams{
+			MutedUntil: sql.NullTime{Time: player.MutedUntil, Valid: true},
+			ID:         player.DbId,
+		})
+		if err != nil {
+			logger.Printf("Error saving mute of player %s: %v", player.Name, err)
+		}
+	}
+	logger.Printf("%s was muted for %v for repeatedly using banned words", player.Name, duration)
+	client.SocketSendAs(packets.NewChat(fmt.Sprintf("You've been muted for %v for repeatedly using banned words", duration)), 0)
+}
+
+// Passes a chat message from another client on to ours, unless our player is ignoring the sender
+func receiveChat(client server.ClientInterfacer, player *objects.Player, senderId uint64, message *packets.Packet_Chat) {
+	if message.Chat.Channel != packets.ChatChannel_CHAT_CHANNEL_ANNOUNCEMENT {
+		if sender, exists := client.OnlinePlayers().Get(senderId); exists {
+			if _, ignored := player.Ignored.Get(uint64(sender.DbId)); ignored {
+				return
+			}
+		}
+	}
+	client.SocketSendAs(message, senderId)
+}
+
+// Finds the client ID of the online player with the given name, ignoring case
+func findOnlinePlayer(client server.ClientInterfacer, name string) (uint64, bool) {
+	var foundId uint64
+	found := false
+	client.OnlinePlayers().ForEach(func(playerId uint64, player *objects.Player) {
+		if !found && strings.EqualFold(player.Name, name) {
+			foundId = playerId
+			found = true
+		}
+	})
+	return foundId, found
+}
+
+// Finds the saved player with the given name, ignoring case and characters which look alike. Only one player can
+// have each name key, so unlike searching by name this can't match someone else.
+func findSavedPlayer(client server.ClientInterfacer, name string) (db.Player, error) {
+	nameKey := auth.NameKey(name)
+	if nameKey == "" {
+		return db.Player{}, sql.ErrNoRows
+	}
+	return client.DbTx().Queries.GetPlayerByNameKey(client.DbTx().Ctx, nameKey)
+}
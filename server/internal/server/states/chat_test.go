@@ -0,0 +1,118 @@
+package states
+
+import (
+	"io"
+	"log"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/server/profanity"
+	"server/pkg/packets"
+	"testing"
+)
+
+// A client which only records what it's asked to send. Anything else it's asked to do panics, since the
+// embedded interface is nil.
+type fakeClient struct {
+	server.ClientInterfacer
+	id            uint64
+	onlinePlayers *objects.SharedCollection[*objects.Player]
+	clients       *objects.SharedCollection[server.ClientInterfacer]
+	gameObjects   *server.SharedGameObjects
+	filter        *profanity.Filter
+	dbTx          *server.DbTx
+	address       string
+
+	sent   []packets.Msg
+	passed map[uint64][]packets.Msg
+	state  server.ClientStateHandler
+}
+
+func newFakeClient(t *testing.T, id uint64) *fakeClient {
+	return &fakeClient{
+		id:            id,
+		onlinePlayers: objects.NewSharedCollection[*objects.Player](),
+		clients:       objects.NewSharedCollection[server.ClientInterfacer](),
+		gameObjects:   &server.SharedGameObjects{Players: objects.NewSharedCollection[*objects.Player]()},
+		filter:        profanity.NewFilter(filepath.Join(t.TempDir(), "missing.txt")),
+		passed:        make(map[uint64][]packets.Msg),
+	}
+}
+
+func (f *fakeClient) Id() uint64 { return f.id }
+
+func (f *fakeClient) OnlinePlayers() *objects.SharedCollection[*objects.Player] {
+	return f.onlinePlayers
+}
+
+func (f *fakeClient) Clients() *objects.SharedCollection[server.ClientInterfacer] { return f.clients }
+
+func (f *fakeClient) SharedGameObjects() *server.SharedGameObjects { return f.gameObjects }
+
+func (f *fakeClient) ChatFilter() *profanity.Filter { return f.filter }
+
+func (f *fakeClient) DbTx() *server.DbTx { return f.dbTx }
+
+// Never in battle royale mode
+func (f *fakeClient) Round() *server.Round { return nil }
+
+func (f *fakeClient) Address() string { return f.address }
+
+func (f *fakeClient) State() server.ClientStateHandler { return f.state }
+
+// Only records the state, without entering it
+func (f *fakeClient) SetState(state server.ClientStateHandler) { f.state = state }
+
+func (f *fakeClient) SocketSend(message packets.Msg) { f.sent = append(f.sent, message) }
+
+func (f *fakeClient) SocketSendAs(message packets.Msg, _ uint64) { f.sent = append(f.sent, message) }
+
+func (f *fakeClient) PassToPeer(message packets.Msg, peerId uint64) {
+	f.passed[peerId] = append(f.passed[peerId], message)
+}
+
+func TestSendWhisper(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     string
+		wantPassed uint64
+		wantDenied bool
+	}{
+		{"to another player", "Bob", 2, false},
+		{"ignoring case", "bob", 2, false},
+		{"to nobody online", "Carol", 0, true},
+		{"to yourself", "Alice", 0, true},
+		{"to yourself ignoring case", "ALICE", 0, true},
+	}
+
+	logger := log.New(io.Discard, "", 0)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newFakeClient(t, 1)
+			alice := &objects.Player{Name: "Alice", Ignored: objects.NewSharedCollection[string]()}
+			client.onlinePlayers.Add(alice, 1)
+			client.onlinePlayers.Add(&objects.Player{Name: "Bob", Ignored: objects.NewSharedCollection[string]()}, 2)
+
+			whisper := packets.NewChat("hello").(*packets.Packet_Chat)
+			whisper.Chat.Channel = packets.ChatChannel_CHAT_CHANNEL_WHISPER
+			whisper.Chat.Target = test.target
+			sendChat(client, logger, alice, whisper)
+
+			if test.wantPassed != 0 && len(client.passed[test.wantPassed]) != 1 {
+				t.Errorf("whisper to %q was passed to %v, want client %d", test.target, client.passed, test.wantPassed)
+			}
+			if test.wantPassed == 0 && len(client.passed) != 0 {
+				t.Errorf("whisper to %q was passed to %v, want nobody", test.target, client.passed)
+			}
+			denied := false
+			for _, message := range client.sent {
+				if _, ok := message.(*packets.Packet_DenyResponse); ok {
+					denied = true
+				}
+			}
+			if denied != test.wantDenied {
+				t.Errorf("whisper to %q denied = %v, want %v", test.target, denied, test.wantDenied)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package states
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"strings"
+	"time"
+)
+
+// What a user is allowed to do, stored as the role of their user. Each role can do everything the ones before it can.
+type role int
+
+const (
+	rolePlayer role = iota
+	roleModerator
+	roleAdmin
+)
+
+func parseRole(name string) role {
+	switch name {
+	case "moderator":
+		return roleModerator
+	case "admin":
+		return roleAdmin
+	}
+	return rolePlayer
+}
+
+// Everything a chat command needs to know about who ran it
+type commandContext struct {
+	client server.ClientInterfacer
+	player *objects.Player
+	logger *log.Logger
+}
+
+// Replies only to the player who ran the command, as the server
+func (c *commandContext) reply(format string, a ...any) {
+	c.client.SocketSendAs(packets.NewChat(fmt.Sprintf(format, a...)), 0)
+}
+
+type chatCommand struct {
+	args        string
+	description string
+	minRole     role
+	run         func(c *commandContext, args []string)
+}
+
+// Filled in by init, since the help command needs to list the others
+var chatCommands map[string]*chatCommand
+
+func init() {
+	chatCommands = map[string]*chatCommand{
+		"help":         {"", "list the commands you can use", rolePlayer, runHelp},
+		"who":          {"", "list the players who are online", rolePlayer, runWho},
+		"whisper":      {"<player> <message>", "send a message only the player can see", rolePlayer, runWhisper},
+		"ignore":       {"<player>", "hide or unhide the player's chat", rolePlayer, runIgnore},
+		"stats":        {"[player]", "show the stats of a player, or your own", rolePlayer, runStats},
+		"rank":         {"", "show your hiscore rank", rolePlayer, runRank},
+		"kick":         {"<player> [reason]", "disconnect the player", roleModerator, runKick},
+		"mute":         {"<player> <duration>", "stop the player from chatting, a duration of 0 unmutes them", roleModerator, runMute},
+		"chatlog":      {"<player> [text]", "search the player's recent chat", roleModerator, runChatLog},
+		"announce":     {"<message>", "send a message to everyone online", roleAdmin, runAnnounce},
+		"reloadfilter": {"", "reload the banned words file", roleAdmin, runReloadFilter},
+	}
+}
+
+// Runs a chat message starting with a slash as a command, replying privately with the outcome
+func runChatCommand(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, msg string) {
+	c := &commandContext{client, player, logger}
+	fields := strings.Fields(strings.TrimPrefix(msg, "/"))
+	if len(fields) == 0 {
+		c.reply("Type /help to see the commands you can use")
+		return
+	}
+
+	name := strings.ToLower(fields[0])
+	command, exists := chatCommands[name]
+	if !exists || parseRole(player.Role) < command.minRole {
+		c.reply("Unknown command /%s - type /help to see the commands you can use", name)
+		return
+	}
+
+	logger.Printf("%s ran /%s", player.Name, name)
+	command.run(c, fields[1:])
+}
+
+func runHelp(c *commandContext, _ []string) {
+	names := make([]string, 0, len(chatCommands))
+	for name, command := range chatCommands {
+		if parseRole(c.player.Role) >= command.minRole {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		command := chatCommands[name]
+		lines = append(lines, strings.TrimSpace(fmt.Sprintf("/%s %s", name, command.args))+" - "+command.description)
+	}
+	c.reply("%s", strings.Join(lines, "\n"))
+}
+
+func runWho(c *commandContext, _ []string) {
+	names := make([]string, 0)
+	c.client.OnlinePlayers().ForEach(func(playerId uint64, player *objects.Player) {
+		if _, inGame := c.client.SharedGameObjects().Players.Get(playerId); inGame {
+			names = append(names, player.Name)
+		} else {
+			names = append(names, player.Name+" (dead)")
+		}
+	})
+	slices.Sort(names)
+	c.reply("%d online: %s", len(names), strings.Join(names, ", "))
+}
+
+func runWhisper(c *commandContext, args []string) {
+	if len(args) < 2 {
+		c.reply("Usage: /whisper <player> <message>")
+		return
+	}
+	whisper := packets.NewChat(strings.Join(args[1:], " ")).(*packets.Packet_Chat)
+	whisper.Chat.Channel = packets.ChatChannel_CHAT_CHANNEL_WHISPER
+	whisper.Chat.Target = args[0]
+	sendChat(c.client, c.logger, c.player, whisper)
+}
+
+func runIgnore(c *commandContext, args []string) {
+	if len(args) != 1 {
+		c.reply("Usage: /ignore <player>")
+		return
+	}
+	other, err := findSavedPlayer(c.client, args[0])
+	if err != nil {
+		c.reply("No player found with that name")
+		return
+	}
+	if other.ID == c.player.DbId {
+		c.reply("You can't ignore yourself")
+		return
+	}
+
+	if _, ignored := c.player.Ignored.Get(uint64(other.ID)); ignored {
+		c.player.Ignored.Remove(uint64(other.ID))
+		c.reply("You're no longer ignoring %s", other.Name)
+	} else {
+		c.player.Ignored.Add(other.Name, uint64(other.ID))
+		c.reply("You're now ignoring %s", other.Name)
+	}
+}
+
+func runStats(c *commandContext, args []string) {
+	name := c.player.Name
+	if len(args) > 0 {
+		name = args[0]
+	}
+	dbTx := c.client.DbTx()
+	player, err := findSavedPlayer(c.client, name)
+	if err != nil {
+		c.reply("No player found with that name")
+		return
+	}
+	rank, err := dbTx.Queries.GetPlayerRank(dbTx.Ctx, player.ID)
+	if err != nil {
+		c.logger.Printf("Error getting rank for player %s: %v", player.Name, err)
+		c.reply("Failed to get stats - please try again later")
+		return
+	}
+
+	stats := fmt.Sprintf("%s - best score: %d, rank: #%d", player.Name, player.BestScore, rank)
+	if playerId, online := findOnlinePlayer(c.client, player.Name); online {
+		if inGame, exists := c.client.SharedGameObjects().Players.Get(playerId); exists {
+			stats += fmt.Sprintf(", mass: %.0f, cells: %d", inGame.Mass(), inGame.Cells.Len())
+		}
+	}
+	c.reply("%s", stats)
+}
+
+func runRank(c *commandContext, _ []string) {
+	if c.player.IsGuest() {
+		c.reply("Guests aren't ranked - register to get on the hiscores")
+		return
+	}
+	rank, err := c.client.DbTx().Queries.GetPlayerRank(c.client.DbTx().Ctx, c.player.DbId)
+	if err != nil {
+		c.logger.Printf("Error getting rank for player %s: %v", c.player.Name, err)
+		c.reply("Failed to get your rank - please try again later")
+		return
+	}
+	c.reply("Your hiscore rank is #%d with a best score of %d", rank, c.player.BestScore)
+}
+
+func runKick(c *commandContext, args []string) {
+	if len(args) < 1 {
+		c.reply("Usage: /kick <player> [reason]")
+		return
+	}
+	targetId, online := findOnlinePlayer(c.client, args[0])
+	if !online {
+		c.reply("%s is not online", args[0])
+		return
+	}
+	if targetId == c.client.Id() {
+		c.reply("You can't kick yourself")
+		return
+	}
+	target, exists := c.client.Clients().Get(targetId)
+	if !exists {
+		c.reply("%s is not online", args[0])
+		return
+	}
+
+	reason := strings.Join(args[1:], " ")
+	if reason == "" {
+		reason = "no reason given"
+	}
+	// The target tells its player why, then closes itself once that's been sent
+	kickMessage := packets.NewChat(fmt.Sprintf("You were kicked by %s: %s", c.player.Name, reason))
+	closeReason := fmt.Sprintf("kicked by %s: %s", c.player.Name, reason)
+	target.Enqueue(func() {
+		target.SocketSendAs(kickMessage, 0)
+		target.Close(closeReason)
+	})
+	c.reply("Kicked %s", args[0])
+}
+
+func runMute(c *commandContext, args []string) {
+	if len(args) != 2 {
+		c.reply("Usage: /mute <player> <duration>, e.g. /mute bob 10m")
+		return
+	}
+	duration, err := time.ParseDuration(args[1])
+	if err != nil || duration < 0 {
+		c.reply("Invalid duration %q, use something like 30s, 10m or 2h", args[1])
+		return
+	}
+
+	var mutedUntil time.Time
+	if duration > 0 {
+		mutedUntil = time.Now().Add(duration)
+	}
+
+	// Guests aren't saved, so they can only be muted for as long as they're online
+	if targetId, online := findOnlinePlayer(c.client, args[0]); online {
+		if guest, exists := c.client.OnlinePlayers().Get(targetId); exists && guest.IsGuest() {
+			if targetClient, exists := c.client.Clients().Get(targetId); exists {
+				targetClient.Enqueue(func() {
+					guest.MutedUntil = mutedUntil
+				})
+			}
+			if duration > 0 {
+				c.reply("Muted guest %s for %v, or until they leave", guest.Name, duration)
+			} else {
+				c.reply("Unmuted guest %s", guest.Name)
+			}
+			return
+		}
+	}
+
+	dbTx := c.client.DbTx()
+	target, err := findSavedPlayer(c.client, args[0])
+	if err != nil {
+		c.reply("No player found with that name")
+		return
+	}
+
+	err = dbTx.Queries.MutePlayer(dbTx.Ctx, db.MutePlayerParams{
+		MutedUntil: sql.NullTime{Time: mutedUntil, Valid: duration > 0},
+		ID:         target.ID,
+	})
+	if err != nil {
+		c.logger.Printf("Error muting player %s: %v", target.Name, err)
+		c.reply("Failed to mute %s - please try again later", target.Name)
+		return
+	}
+
+	// Online players keep their mute in memory, so it needs updating there too, by their own client
+	if targetId, online := findOnlinePlayer(c.client, target.Name); online {
+		if targetClient, exists := c.client.Clients().Get(targetId); exists {
+			targetClient.Enqueue(func() {
+				if player, exists := targetClient.OnlinePlayers().Get(targetId); exists {
+					player.MutedUntil = mutedUntil
+				}
+			})
+		}
+	}
+
+	if duration > 0 {
+		c.reply("Muted %s for %v", target.Name, duration)
+	} else {
+		c.reply("Unmuted %s", target.Name)
+	}
+}
+
+func runAnnounce(c *commandContext, args []string) {
+	if len(args) == 0 {
+		c.reply("Usage: /announce <message>")
+		return
+	}
+	announcement := packets.NewChat(strings.Join(args, " ")).(*packets.Packet_Chat)
+	announcement.Chat.Channel = packets.ChatChannel_CHAT_CHANNEL_ANNOUNCEMENT
+	announcement.Chat.SenderName = c.player.Name
+	c.client.Broadcast(announcement)
+	c.client.SocketSend(announcement)
+}
+
+func runReloadFilter(c *commandContext, _ []string) {
+	if err := c.client.ChatFilter().Reload(); err != nil {
+		c.logger.Printf("Error reloading banned words: %v", err)
+		c.reply("Failed to reload the banned words: %v", err)
+		return
+	}
+	c.reply("Reloaded the banned words")
+}
+
+func runChatLog(c *commandContext, args []string) {
+	if len(args) < 1 {
+		c.reply("Usage: /chatlog <player> [text]")
+		return
+	}
+	dbTx := c.client.DbTx()
+	target, err := findSavedPlayer(c.client, args[0])
+	if err != nil {
+		c.reply("No player found with that name")
+		return
+	}
+
+	const limit int64 = 20
+	messages, err := dbTx.Queries.SearchPlayerChatMessages(dbTx.Ctx, db.SearchPlayerChatMessagesParams{
+		PlayerID: target.ID,
+		Text:     "%" + strings.Join(args[1:], " ") + "%",
+		Limit:    limit,
+	})
+	if err != nil {
+		c.logger.Printf("Error searching chat of player %s: %v", target.Name, err)
+		c.reply("Failed to search the chat - please try again later")
+		return
+	}
+	if len(messages) == 0 {
+		c.reply("No chat messages found from %s", target.Name)
+		return
+	}
+
+	lines := make([]string, 0, len(messages)+1)
+	lines = append(lines, fmt.Sprintf("Latest %d chat messages from %s:", len(messages), target.Name))
+	for i := len(messages) - 1; i >= 0; i-- {
+		message := messages[i]
+		channel := strings.ToLower(strings.TrimPrefix(packets.ChatChannel(message.Channel).String(), "CHAT_CHANNEL_"))
+		if message.Target != "" {
+			channel += " to " + message.Target
+		}
+		lines = append(lines, fmt.Sprintf("[%s] (%s) %s", message.SentAt.Format(time.DateTime), channel, message.Text))
+	}
+	c.reply("%s", strings.Join(lines, "\n"))
+}
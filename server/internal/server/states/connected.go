@@ -2,16 +2,18 @@ package states
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"server/internal/server"
+	"server/internal/server/auth"
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
 	"strings"
-
-	"golang.org/x/crypto/bcrypt"
+	"time"
 )
 
 type Connected struct {
@@ -19,6 +21,13 @@ type Connected struct {
 	logger  *log.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+
+	// Set while a login or registration is waiting on a login worker, so clients can only queue one at a time
+	busy bool
+}
+
+func (c *Connected) busyFlag() *bool {
+	return &c.busy
 }
 
 func (c *Connected) Name() string {
@@ -28,12 +37,17 @@ func (c *Connected) Name() string {
 func (c *Connected) SetClient(client server.ClientInterfacer) {
 	c.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), c.Name())
-	c.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	c.logger = server.NewClientLogger(loggingPrefix)
 	c.queries = client.DbTx().Queries
 	c.dbCtx = client.DbTx().Ctx
 }
 
 func (c *Connected) OnEnter() {
+	// Back to the login screen, so we're no longer online or in a party
+	if player, online := c.client.OnlinePlayers().Get(c.client.Id()); online {
+		server.LeaveParty(c.client.Clients(), c.client.Id(), player)
+	}
+	c.client.OnlinePlayers().Remove(c.client.Id())
 	c.client.SocketSend(packets.NewId(c.client.Id()))
 }
 
@@ -43,6 +57,8 @@ func (c *Connected) HandlerMessage(senderId uint64, message packets.Msg) {
 		c.handleLoginRequest(senderId, message)
 	case *packets.Packet_RegisterRequest:
 		c.handleRegisterRequest(senderId, message)
+	case *packets.Packet_GuestLogin:
+		c.handleGuestLogin(senderId, message)
 	case *packets.Packet_HiscoreBoardRequest:
 		c.handleHiscoreBoardRequest(senderId, message)
 	}
@@ -57,21 +73,83 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 		c.logger.Printf("Received login request from another client (Id %v)", senderId)
 		return
 	}
-	username := message.LoginRequest.Username
+	request := message.LoginRequest
+	runOnLoginWorker(c.client, c.logger, c.busyFlag, func() func() {
+		identity, err := c.client.Authenticator().Authenticate(c.dbCtx, request.Username, request.Password)
+		return func() { c.login(request.Username, identity, err) }
+	})
+}
+
+// Checking passwords is slow on purpose, so it's left to the hub's login workers. That way the client's other packets
+// aren't held up, and a burst of logins can't take every CPU away from the game. Only the work runs on a worker, so it
+// mustn't touch the client's state. It returns what to do with its result, which is done back on the client's own
+// goroutine. The flag busy gives is set until then, so each client can only have one job waiting at a time. It's looked
+// up again once the job's done, since it can have moved by then.
+func runOnLoginWorker(client server.ClientInterfacer, logger *log.Logger, busy func() *bool, work func() func()) {
+	if *busy() {
+		client.SocketSend(packets.NewDenyResponse("Still working on your last request - please wait"))
+		return
+	}
+	*busy() = true
+	queued := client.LoginWorkers().Submit(func() {
+		done := work()
+		client.Enqueue(func() {
+			*busy() = false
+			done()
+		})
+	})
+	if !queued {
+		*busy() = false
+		logger.Println("Login workers are overloaded, turning the client away")
+		client.SocketSend(packets.NewDenyResponse("The server is busy - please try again in a moment"))
+	}
+}
+
+// Logs the client in as the user the authenticator said they were, once it's checked their password
+func (c *Connected) login(username string, identity auth.Identity, err error) {
 	genericErrorMessage := packets.NewDenyResponse("Incorrect username or password")
-	user, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username))
+	// The client could have logged in as a guest or gone while we were waiting
+	if c.client.State() != c {
+		return
+	}
 	if err != nil {
-		c.logger.Printf("Error getting user by Username: %v", err)
+		c.logger.Printf("Failed login for %s: %v", username, err)
 		c.client.SocketSend(genericErrorMessage)
 		return
 	}
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(message.LoginRequest.Password))
-	if err != nil {
-		c.logger.Printf("User entered wrong password: %s", username)
+	var user db.User
+	if identity.ExternalID != "" {
+		username = identity.Name
+		user, err = c.queries.GetUserByExternalID(c.dbCtx, sql.NullString{String: identity.ExternalID, Valid: true})
+	} else {
+		username = identity.Username
+		user, err = c.queries.GetUserByUsername(c.dbCtx, username)
+	}
+	if errors.Is(err, sql.ErrNoRows) && identity.ExternalID != "" {
+		user, err = c.createExternalUser(identity)
+		if err != nil {
+			c.logger.Printf("Error creating user %s: %v", username, err)
+			c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Couldn't create your player: %v", err)))
+			return
+		}
+	} else if err != nil {
+		c.logger.Printf("Error getting user by Username: %v", err)
 		c.client.SocketSend(genericErrorMessage)
 		return
 	}
 
+	if user.BannedUntil.Valid && time.Now().Before(user.BannedUntil.Time) {
+		c.logger.Printf("Banned user tried to log in: %s", username)
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You're banned until %s", user.BannedUntil.Time.Format(time.DateTime))))
+		return
+	}
+
+	// Banning a user also keeps anyone at their address from playing as a guest or registering again
+	err = c.queries.UpdateUserAddress(c.dbCtx, db.UpdateUserAddressParams{LastAddress: c.client.Address(), ID: user.ID})
+	if err != nil {
+		c.logger.Printf("Error saving the address of user %s: %v", username, err)
+	}
+
 	player, err := c.queries.GetPlayerByUserID(c.dbCtx, user.ID)
 
 	if err != nil {
@@ -84,89 +162,291 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 	c.client.SocketSend(packets.NewOkResponse())
 
 	//Transition from connected state to Ingame state
-	c.client.SetState(&InGame{
-		player: &objects.Player{
-			Name:      player.Name,
-			DbId:      player.ID,
-			BestScore: player.BestScore,
-			Color:     int32(player.Color),
-		},
+	c.enterGame(&objects.Player{
+		Name:       player.Name,
+		DbId:       player.ID,
+		BestScore:  player.BestScore,
+		Color:      int32(player.Color),
+		Role:       user.Role,
+		MutedUntil: user.MutedUntil.Time,
+		Ignored:    objects.NewSharedCollection[string](),
 	})
 
 }
 
-func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Packet_RegisterRequest) {
+// Puts the player who just logged in into the game. A battle royale match is only for those who were there when it
+// started, so anyone logging in during one sits it out with the dead until the next round, the same as if they'd
+// tried to respawn.
+func (c *Connected) enterGame(player *objects.Player) {
+	if round := c.client.Round(); round != nil && round.Phase() == server.RoundMatch {
+		c.client.SetState(&Dead{player: player, joinedMidMatch: true})
+		return
+	}
+	c.client.SetState(&InGame{player: player})
+}
+
+// Saves a user who was authenticated somewhere else, the first time they log in, along with their player. They
+// don't have a password hash, so they can only ever log in through the authenticator which vouched for them. Their
+// external ID doubles as their username, which registered users can't take since it has characters names can't.
+func (c *Connected) createExternalUser(identity auth.Identity) (db.User, error) {
+	if err := c.client.AccountPolicy().CheckUsername(identity.Name); err != nil {
+		return db.User{}, fmt.Errorf("the name %s is invalid: %w", identity.Name, err)
+	}
+	if !c.client.ChatFilter().IsCleanName(identity.Name) {
+		return db.User{}, fmt.Errorf("the name %s contains banned words", identity.Name)
+	}
+	nameKey := auth.NameKey(identity.Name)
+	if other, err := c.queries.GetPlayerByNameKey(c.dbCtx, nameKey); err == nil {
+		return db.User{}, fmt.Errorf("the name %s is too similar to the existing player %s", identity.Name, other.Name)
+	}
+
+	var user db.User
+	err := c.client.DbTx().InTransaction(func(queries *db.Queries) error {
+		var err error
+		user, err = queries.CreateExternalUser(c.dbCtx, db.CreateExternalUserParams{
+			Username:   identity.ExternalID,
+			ExternalID: sql.NullString{String: identity.ExternalID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = queries.CreatePlayer(c.dbCtx, db.CreatePlayerParams{
+			UserID:  user.ID,
+			Name:    identity.Name,
+			Color:   int64(rand.Uint32() | 0xFF),
+			NameKey: nameKey,
+		})
+		return err
+	})
+	if server.IsUniqueViolation(err) {
+		return db.User{}, fmt.Errorf("the name %s is taken", identity.Name)
+	} else if err != nil {
+		return db.User{}, errors.New("internal server error")
+	}
+	c.logger.Printf("Created user %s (%s) on their first login", identity.Name, identity.ExternalID)
+	return user, nil
+}
+
+// Lets the client play straight away as a guest with a made up name. Nothing about guests is saved, unless they
+// register while they're playing.
+func (c *Connected) handleGuestLogin(senderId uint64, _ *packets.Packet_GuestLogin) {
 	if senderId != c.client.Id() {
-		c.logger.Printf("Received register message from another client (Id %d)", senderId)
+		c.logger.Printf("Received guest login from another client (Id %d)", senderId)
 		return
 	}
 
-	username := strings.ToLower(message.RegisterRequest.Username)
-	err := validateUsername(message.RegisterRequest.Username)
+	restrictions, err := addressRestrictions(c.client)
 	if err != nil {
-		reason := fmt.Sprintf("Invalid username: %v", err)
-		c.logger.Println(reason)
-		c.client.SocketSend(packets.NewDenyResponse(reason))
+		c.logger.Printf("Error checking the address of a guest: %v", err)
+		c.client.SocketSend(packets.NewDenyResponse("Couldn't log you in as a guest - please try again later"))
+		return
+	}
+	if restrictions.banned() {
+		c.logger.Printf("Refused a guest at the address of a banned user")
+		c.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You're banned until %s", restrictions.bannedUntil.Format(time.DateTime))))
 		return
 	}
 
-	_, err = c.queries.GetUserByUsername(c.dbCtx, username)
-	if err == nil {
-		c.logger.Printf("User already exists: %s", username)
-		c.client.SocketSend(packets.NewDenyResponse("User already exists"))
+	name, ok := c.guestName()
+	if !ok {
+		c.client.SocketSend(packets.NewDenyResponse("Couldn't find a free guest name - please try again"))
 		return
 	}
 
-	genericFailMessage := packets.NewDenyResponse("Error registering user (internal server error) - please try again later")
+	c.logger.Printf("Guest %s logged in", name)
+	c.client.SocketSend(packets.NewOkResponse())
+	c.enterGame(&objects.Player{
+		Name:       name,
+		Color:      int32(rand.Uint32() | 0xFF),
+		Role:       "player",
+		MutedUntil: restrictions.mutedUntil,
+		Ignored:    objects.NewSharedCollection[string](),
+	})
+}
+
+// How long the users who last logged in from an address are banned and muted for, going by the longest of each
+type restrictions struct {
+	bannedUntil time.Time
+	mutedUntil  time.Time
+}
+
+func (r restrictions) banned() bool {
+	return time.Now().Before(r.bannedUntil)
+}
 
-	// Add new user
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(message.RegisterRequest.Password), bcrypt.DefaultCost)
+// Guests and new accounts can't be told apart from a banned or muted player coming back under another name, except
+// by where they're connecting from
+func addressRestrictions(client server.ClientInterfacer) (restrictions, error) {
+	var found restrictions
+	users, err := client.DbTx().Queries.ListUserRestrictionsByAddress(client.DbTx().Ctx, client.Address())
 	if err != nil {
-		c.logger.Printf("Failed to hash password: %s", username)
-		c.client.SocketSend(genericFailMessage)
+		return found, err
+	}
+	for _, user := range users {
+		if user.BannedUntil.Valid && user.BannedUntil.Time.After(found.bannedUntil) {
+			found.bannedUntil = user.BannedUntil.Time
+		}
+		if user.MutedUntil.Valid && user.MutedUntil.Time.After(found.mutedUntil) {
+			found.mutedUntil = user.MutedUntil.Time
+		}
+	}
+	return found, nil
+}
+
+// Picks a guest name which nobody online or registered has
+func (c *Connected) guestName() (string, bool) {
+	const maxTries int = 10
+	for tries := 0; tries < maxTries; tries++ {
+		name := fmt.Sprintf("Guest%04d", rand.IntN(10000))
+		if _, online := findOnlinePlayer(c.client, name); online {
+			continue
+		}
+		if _, err := c.queries.GetPlayerByNameKey(c.dbCtx, auth.NameKey(name)); err == nil {
+			continue
+		}
+		return name, true
+	}
+	return "", false
+}
+
+func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Packet_RegisterRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Received register message from another client (Id %d)", senderId)
 		return
 	}
 
-	user, err := c.queries.CreateUser(c.dbCtx, db.CreateUserParams{
-		Username:     username,
-		PasswordHash: string(passwordHash),
+	registerUser(c.client, c.logger, c.busyFlag, message.RegisterRequest, func(db.Player, time.Time) {
+		if c.client.State() == c {
+			c.client.SocketSend(packets.NewOkResponse())
+		}
 	})
+}
 
-	if err != nil {
-		c.logger.Printf("Failed to create user %s: %v", username, err)
-		c.client.SocketSend(genericFailMessage)
+// Creates a user and their player if the request follows the account policy, otherwise lets the client know why not.
+// The password is hashed on a login worker, and once the user's been created their player is passed to registered,
+// along with when the mute they've taken on from their address ends.
+func registerUser(client server.ClientInterfacer, logger *log.Logger, busy func() *bool, request *packets.RegisterRequestMessage, registered func(db.Player, time.Time)) {
+	dbTx := client.DbTx()
+	if !client.Authenticator().AllowsRegistration() {
+		client.SocketSend(packets.NewDenyResponse("Accounts can't be registered on this server"))
 		return
 	}
-	//Add player here
-	_, err = c.queries.CreatePlayer(c.dbCtx, db.CreatePlayerParams{
-		UserID: user.ID,
-		Name:   message.RegisterRequest.Username,
-		Color:  int64(message.RegisterRequest.Color),
-	})
+
+	restrictions, err := addressRestrictions(client)
 	if err != nil {
-		c.logger.Printf("Failed to create player for user %s: %v", username, err)
-		c.client.SocketSend(genericFailMessage)
+		logger.Printf("Error checking the address of a new user: %v", err)
+		client.SocketSend(packets.NewDenyResponse("Error registering user (internal server error) - please try again later"))
+		return
+	}
+	if restrictions.banned() {
+		logger.Printf("Refused to register a user at the address of a banned user")
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You're banned until %s", restrictions.bannedUntil.Format(time.DateTime))))
 		return
 	}
 
-	c.client.SocketSend(packets.NewOkResponse())
+	name := request.Username
+	username := strings.ToLower(name)
+	err = client.AccountPolicy().CheckUsername(name)
+	if err != nil {
+		reason := fmt.Sprintf("Invalid username: %v", err)
+		logger.Println(reason)
+		client.SocketSend(packets.NewDenyResponse(reason))
+		return
+	}
 
-	c.logger.Printf("User %s registered successfully", username)
-}
+	if !client.ChatFilter().IsCleanName(name) {
+		logger.Printf("Username contains banned words: %s", username)
+		client.SocketSend(packets.NewDenyResponse("Invalid username: contains banned words"))
+		return
+	}
 
-func (c *Connected) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
-	c.client.SetState(&BrowsingHiscores{})
-}
+	err = client.AccountPolicy().CheckPassword(request.Password, name)
+	if err != nil {
+		logger.Printf("Password for %s rejected: %v", username, err)
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Invalid password: %v", err)))
+		return
+	}
 
-func validateUsername(username string) error {
-	if len(username) <= 0 {
-		return errors.New("empty")
+	// Usernames aren't case sensitive, so "Bob" is taken if "bob" is
+	_, err = dbTx.Queries.GetUserByUsername(dbTx.Ctx, username)
+	if err == nil {
+		logger.Printf("User already exists: %s", username)
+		client.SocketSend(packets.NewDenyResponse("Username already taken"))
+		return
 	}
-	if len(username) > 20 {
-		return errors.New("too long")
+
+	// Players could also have changed their name to this one, or one that looks just like it
+	nameKey := auth.NameKey(name)
+	if other, err := dbTx.Queries.GetPlayerByNameKey(dbTx.Ctx, nameKey); err == nil {
+		logger.Printf("Username %s looks like existing player %s", username, other.Name)
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Username too similar to the existing player %s", other.Name)))
+		return
 	}
-	if username != strings.TrimSpace(username) {
-		return errors.New("leading or trailing whitespace")
+
+	runOnLoginWorker(client, logger, busy, func() func() {
+		passwordHash, err := client.PasswordHasher().Hash(request.Password)
+		return func() {
+			if err != nil {
+				logger.Printf("Failed to hash password: %s", username)
+				client.SocketSend(packets.NewDenyResponse("Error registering user (internal server error) - please try again later"))
+				return
+			}
+			if player, ok := createUser(client, logger, request, nameKey, passwordHash, restrictions.mutedUntil); ok {
+				registered(player, restrictions.mutedUntil)
+			}
+		}
+	})
+}
+
+// Saves the new user and their player, which registerUser has already checked the request for, muted until
+// mutedUntil if that's still to come
+func createUser(client server.ClientInterfacer, logger *log.Logger, request *packets.RegisterRequestMessage, nameKey string, passwordHash string, mutedUntil time.Time) (db.Player, bool) {
+	dbTx := client.DbTx()
+	name := request.Username
+	username := strings.ToLower(name)
+
+	var player db.Player
+	err := dbTx.InTransaction(func(queries *db.Queries) error {
+		user, err := queries.CreateUser(dbTx.Ctx, db.CreateUserParams{
+			Username:     username,
+			PasswordHash: passwordHash,
+		})
+		if err != nil {
+			return err
+		}
+		err = queries.UpdateUserAddress(dbTx.Ctx, db.UpdateUserAddressParams{LastAddress: client.Address(), ID: user.ID})
+		if err != nil {
+			return err
+		}
+		player, err = queries.CreatePlayer(dbTx.Ctx, db.CreatePlayerParams{
+			UserID:  user.ID,
+			Name:    name,
+			Color:   int64(request.Color),
+			NameKey: nameKey,
+		})
+		if err != nil || !time.Now().Before(mutedUntil) {
+			return err
+		}
+		return queries.MutePlayer(dbTx.Ctx, db.MutePlayerParams{
+			MutedUntil: sql.NullTime{Time: mutedUntil, Valid: true},
+			ID:         player.ID,
+		})
+	})
+	// Someone else could have taken the name since we checked
+	if server.IsUniqueViolation(err) {
+		logger.Printf("Username %s was taken while registering", username)
+		client.SocketSend(packets.NewDenyResponse("Username already taken"))
+		return db.Player{}, false
+	} else if err != nil {
+		logger.Printf("Failed to create user %s: %v", username, err)
+		client.SocketSend(packets.NewDenyResponse("Error registering user (internal server error) - please try again later"))
+		return db.Player{}, false
 	}
-	return nil
+
+	logger.Printf("User %s registered successfully", username)
+	return player, true
+}
+
+func (c *Connected) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
+	c.client.SetState(&BrowsingHiscores{})
 }
@@ -0,0 +1,90 @@
+package states
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// A database with the server's schema, which only lasts as long as the test
+func newTestDbTx(t *testing.T) *server.DbTx {
+	t.Helper()
+	pool, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection to :memory: gets its own database
+	pool.SetMaxOpenConns(1)
+	t.Cleanup(func() { pool.Close() })
+
+	schema, err := os.ReadFile("../db/config/schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Exec(string(schema)); err != nil {
+		t.Fatal(err)
+	}
+	return &server.DbTx{Ctx: context.Background(), Queries: db.New(pool)}
+}
+
+// Saves a user who last logged in from the address, muted until mutedUntil
+func createMutedUser(t *testing.T, dbTx *server.DbTx, address string, mutedUntil time.Time) {
+	t.Helper()
+	user, err := dbTx.Queries.CreateUser(dbTx.Ctx, db.CreateUserParams{Username: "bob", PasswordHash: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dbTx.Queries.UpdateUserAddress(dbTx.Ctx, db.UpdateUserAddressParams{LastAddress: address, ID: user.ID}); err != nil {
+		t.Fatal(err)
+	}
+	player, err := dbTx.Queries.CreatePlayer(dbTx.Ctx, db.CreatePlayerParams{UserID: user.ID, Name: "Bob", NameKey: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dbTx.Queries.MutePlayer(dbTx.Ctx, db.MutePlayerParams{MutedUntil: sql.NullTime{Time: mutedUntil, Valid: true}, ID: player.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGuestLoginFromMutedAddress(t *testing.T) {
+	mutedUntil := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		address   string
+		wantMuted bool
+	}{
+		{"same address", "203.0.113.1", true},
+		{"other address", "203.0.113.2", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newFakeClient(t, 1)
+			client.dbTx = newTestDbTx(t)
+			client.address = test.address
+			createMutedUser(t, client.dbTx, "203.0.113.1", mutedUntil)
+
+			connected := &Connected{}
+			connected.SetClient(client)
+			connected.handleGuestLogin(client.id, &packets.Packet_GuestLogin{})
+
+			inGame, ok := client.state.(*InGame)
+			if !ok {
+				t.Fatalf("guest login switched to %v, sent %v, want in game", client.state, client.sent)
+			}
+			if muted := time.Now().Before(inGame.player.MutedUntil); muted != test.wantMuted {
+				t.Errorf("guest muted = %v until %v, want %v", muted, inGame.player.MutedUntil, test.wantMuted)
+			}
+			if test.wantMuted && inGame.player.MutedUntil.Sub(mutedUntil).Abs() > time.Second {
+				t.Errorf("guest muted until %v, want %v", inGame.player.MutedUntil, mutedUntil)
+			}
+		})
+	}
+}
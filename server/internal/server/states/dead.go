@@ -0,0 +1,187 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+type Dead struct {
+	client       server.ClientInterfacer
+	player       *objects.Player
+	logger       *log.Logger
+	killerId     uint64
+	killerName   string
+	survivalTime time.Duration
+	peakMass     float64
+	diedAt       time.Time
+
+	// Logged in during a battle royale match, so hasn't played yet and is waiting for the next round
+	joinedMidMatch bool
+}
+
+func (d *Dead) Name() string {
+	return "Dead"
+}
+
+func (d *Dead) SetClient(client server.ClientInterfacer) {
+	d.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), d.Name())
+	d.logger = server.NewClientLogger(loggingPrefix)
+}
+
+func (d *Dead) OnEnter() {
+	// Coming back from browsing the hiscores doesn't reset the respawn delay
+	if d.diedAt.IsZero() {
+		d.diedAt = time.Now()
+	}
+
+	if d.joinedMidMatch {
+		comeOnline(d.client, d.logger, d.player)
+		d.logger.Printf("%s is waiting for the match to finish", d.player.Name)
+		d.client.SocketSend(packets.NewWorldInfo(&d.client.Rules().Arena))
+		if round := d.client.Round(); round != nil {
+			d.client.SocketSend(round.PhaseMessage())
+		}
+		return
+	}
+
+	// The best score was already synced when leaving the game, so the rank is up to date. Guests aren't ranked.
+	var rank int64
+	if !d.player.IsGuest() {
+		var err error
+		rank, err = d.client.DbTx().Queries.GetPlayerRank(d.client.DbTx().Ctx, d.player.DbId)
+		if err != nil {
+			d.logger.Printf("Error getting rank for player %s: %v", d.player.Name, err)
+			rank = 0
+		}
+	}
+
+	d.logger.Printf("%s was consumed by %s after %v", d.player.Name, d.killerName, d.survivalTime)
+	d.client.SocketSend(packets.NewDeath(
+		d.killerId,
+		d.killerName,
+		d.survivalTime,
+		d.peakMass,
+		uint64(rank),
+		d.client.Rules().RespawnDelay,
+	))
+}
+
+func (d *Dead) HandlerMessage(senderId uint64, message packets.Msg) {
+	switch message := message.(type) {
+	case *packets.Packet_RespawnRequest:
+		d.handleRespawnRequest(senderId, message)
+	case *packets.Packet_Chat:
+		d.handleChatMessage(senderId, message)
+	case *packets.Packet_ReportPlayer:
+		d.handleReportPlayer(senderId, message)
+	case *packets.Packet_RoundPhase:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_RoundResult:
+		d.handleRoundResult(senderId, message)
+	case *packets.Packet_HiscoreBoardRequest:
+		d.handleHiscoreBoardRequest(senderId, message)
+	case *packets.Packet_Disconnect:
+		d.handleDisconnect(senderId, message)
+	default:
+		handleLoggedInMessage(d.client, d.logger, d.player, senderId, message)
+	}
+}
+
+func (d *Dead) OnExit() {
+}
+
+func (d *Dead) handleRespawnRequest(senderId uint64, _ *packets.Packet_RespawnRequest) {
+	// Our party leader respawning brings us along, if we're allowed to respawn yet. The leader's own request
+	// isn't from the leader in that sense, so they still get told why they can't respawn.
+	fromLeader := senderId != d.client.Id() && d.player.Party != nil && senderId == d.player.Party.LeaderId()
+	if senderId != d.client.Id() && !fromLeader {
+		d.logger.Printf("Received respawn request from another client (Id %d)", senderId)
+		return
+	}
+
+	if round := d.client.Round(); round != nil && round.Phase() == server.RoundMatch {
+		if !fromLeader {
+			d.client.SocketSend(packets.NewDenyResponse("Can't respawn during a match - please wait for the next round"))
+		}
+		return
+	}
+
+	remaining := d.client.Rules().RespawnDelay - time.Since(d.diedAt)
+	if remaining > 0 {
+		if !fromLeader {
+			d.client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Can't respawn yet - please wait %.1f seconds", remaining.Seconds())))
+		}
+		return
+	}
+
+	d.client.SetState(&InGame{player: respawnedPlayer(d.player)})
+	if !fromLeader {
+		respawnParty(d.client, d.player)
+	}
+}
+
+func (d *Dead) handleReportPlayer(senderId uint64, message *packets.Packet_ReportPlayer) {
+	if senderId != d.client.Id() {
+		return
+	}
+	submitReport(d.client, d.logger, d.player, message)
+}
+
+func (d *Dead) handleHiscoreBoardRequest(senderId uint64, _ *packets.Packet_HiscoreBoardRequest) {
+	if senderId != d.client.Id() {
+		return
+	}
+	d.client.SetState(&BrowsingHiscores{player: d.player, returnTo: d})
+}
+
+// Everyone knocked out of a round gets to play in the next one
+func (d *Dead) handleRoundResult(senderId uint64, message *packets.Packet_RoundResult) {
+	// Only the round decides when it's over
+	if senderId != 0 {
+		d.logger.Printf("Received round result from another client (Id %d)", senderId)
+		return
+	}
+	d.client.SocketSendAs(message, senderId)
+	d.client.SetState(&InGame{player: respawnedPlayer(d.player)})
+}
+
+// Only carries over the persisted profile, chat settings and what the player's waiting on, everything else is reset
+// when entering the game
+func respawnedPlayer(player *objects.Player) *objects.Player {
+	return &objects.Player{
+		Name:                player.Name,
+		DbId:                player.DbId,
+		BestScore:           player.BestScore,
+		Color:               player.Color,
+		Role:                player.Role,
+		MutedUntil:          player.MutedUntil,
+		ChatOffences:        player.ChatOffences,
+		LastReportAt:        player.LastReportAt,
+		AwaitingLoginWorker: player.AwaitingLoginWorker,
+		Ignored:             player.Ignored,
+		Team:                player.Team,
+		Party:               player.Party,
+	}
+}
+
+// Dead players are out of the arena, so they only hear global chat, their team, their party and whispers
+func (d *Dead) handleChatMessage(senderId uint64, message *packets.Packet_Chat) {
+	if senderId == d.client.Id() {
+		sendChat(d.client, d.logger, d.player, message)
+	} else if message.Chat.Channel != packets.ChatChannel_CHAT_CHANNEL_ARENA {
+		receiveChat(d.client, d.player, senderId, message)
+	}
+}
+
+func (d *Dead) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
+	if senderId == d.client.Id() {
+		d.client.SetState(&Connected{})
+	} else {
+		d.client.SocketSendAs(message, senderId)
+	}
+}
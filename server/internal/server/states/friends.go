@@ -0,0 +1,230 @@
+package states
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// The most friends and pending requests a player can have
+const maxFriends int = 100
+
+// Handles the friend packets our own client sends from any of the logged in states, reporting whether it was one
+func handleFriendMessage(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, senderId uint64, message packets.Msg) bool {
+	if senderId != client.Id() {
+		return false
+	}
+
+	switch message.(type) {
+	case *packets.Packet_FriendRequest, *packets.Packet_FriendAccept, *packets.Packet_FriendRemove, *packets.Packet_FriendListRequest:
+		if player.IsGuest() {
+			client.SocketSend(packets.NewDenyResponse("Guests can't have friends - register to add them"))
+			return true
+		}
+	}
+
+	switch message := message.(type) {
+	case *packets.Packet_FriendRequest:
+		sendFriendRequest(client, logger, player, message.FriendRequest.Name)
+	case *packets.Packet_FriendAccept:
+		acceptFriendRequest(client, logger, player, message.FriendAccept.Name)
+	case *packets.Packet_FriendRemove:
+		removeFriend(client, logger, player, message.FriendRemove.Name)
+	case *packets.Packet_FriendListRequest:
+		sendFriendList(client, logger, player)
+	default:
+		return false
+	}
+	return true
+}
+
+func sendFriendRequest(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	dbTx := client.DbTx()
+	other, err := findSavedPlayer(client, name)
+	if err != nil {
+		client.SocketSend(packets.NewDenyResponse("No player found with that name"))
+		return
+	}
+	if other.ID == player.DbId {
+		client.SocketSend(packets.NewDenyResponse("You can't befriend yourself"))
+		return
+	}
+
+	friendship, err := dbTx.Queries.GetFriendship(dbTx.Ctx, db.GetFriendshipParams{PlayerID: player.DbId, FriendID: other.ID})
+	if err == nil {
+		// Asking someone who already asked us is as good as accepting
+		if friendship.Status == "pending" && friendship.PlayerID == other.ID {
+			acceptFriendRequest(client, logger, player, other.Name)
+			return
+		}
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You've already added %s", other.Name)))
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		logger.Printf("Error getting friendship with %s: %v", other.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to send the friend request - please try again later"))
+		return
+	}
+
+	// Both players' lists would grow, so both have to have room
+	friends, err := dbTx.Queries.ListFriends(dbTx.Ctx, player.DbId)
+	if err == nil && len(friends) >= maxFriends {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You can't have more than %d friends", maxFriends)))
+		return
+	}
+	otherFriends, err := dbTx.Queries.ListFriends(dbTx.Ctx, other.ID)
+	if err == nil && len(otherFriends) >= maxFriends {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s can't have any more friends", other.Name)))
+		return
+	}
+
+	err = dbTx.Queries.CreateFriendRequest(dbTx.Ctx, db.CreateFriendRequestParams{PlayerID: player.DbId, FriendID: other.ID})
+	if err != nil {
+		logger.Printf("Error sending friend request to %s: %v", other.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to send the friend request - please try again later"))
+		return
+	}
+
+	client.SocketSend(packets.NewFriend(friendEntry(client, other.ID, other.Name, packets.FriendStatus_FRIEND_STATUS_OUTGOING)))
+	if otherClient, online := findOnlineClient(client, other.ID); online {
+		otherClient.SocketSendAs(packets.NewFriend(friendEntry(client, player.DbId, player.Name, packets.FriendStatus_FRIEND_STATUS_INCOMING)), client.Id())
+	}
+}
+
+func acceptFriendRequest(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	dbTx := client.DbTx()
+	other, err := findSavedPlayer(client, name)
+	if err != nil {
+		client.SocketSend(packets.NewDenyResponse("No player found with that name"))
+		return
+	}
+
+	// Only the player who was asked can accept
+	friendship, err := dbTx.Queries.GetFriendship(dbTx.Ctx, db.GetFriendshipParams{PlayerID: player.DbId, FriendID: other.ID})
+	if err != nil || friendship.Status != "pending" || friendship.PlayerID != other.ID {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("No friend request from %s", other.Name)))
+		return
+	}
+
+	err = dbTx.Queries.AcceptFriendRequest(dbTx.Ctx, db.AcceptFriendRequestParams{PlayerID: other.ID, FriendID: player.DbId})
+	if err != nil {
+		logger.Printf("Error accepting friend request from %s: %v", other.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to accept the friend request - please try again later"))
+		return
+	}
+
+	client.SocketSend(packets.NewFriend(friendEntry(client, other.ID, other.Name, packets.FriendStatus_FRIEND_STATUS_ACCEPTED)))
+	if otherClient, online := findOnlineClient(client, other.ID); online {
+		otherClient.SocketSendAs(packets.NewFriend(friendEntry(client, player.DbId, player.Name, packets.FriendStatus_FRIEND_STATUS_ACCEPTED)), client.Id())
+	}
+}
+
+// Removes a friend, or declines or cancels a pending request
+func removeFriend(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	dbTx := client.DbTx()
+	other, err := findSavedPlayer(client, name)
+	if err != nil {
+		client.SocketSend(packets.NewDenyResponse("No player found with that name"))
+		return
+	}
+
+	err = dbTx.Queries.DeleteFriendship(dbTx.Ctx, db.DeleteFriendshipParams{PlayerID: player.DbId, FriendID: other.ID})
+	if err != nil {
+		logger.Printf("Error removing friend %s: %v", other.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to remove the friend - please try again later"))
+		return
+	}
+	client.SocketSend(packets.NewOkResponse())
+}
+
+func sendFriendList(client server.ClientInterfacer, logger *log.Logger, player *objects.Player) {
+	friends, err := client.DbTx().Queries.ListFriends(client.DbTx().Ctx, player.DbId)
+	if err != nil {
+		logger.Printf("Error getting friends of %s: %v", player.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to get your friends - please try again later"))
+		return
+	}
+
+	entries := make([]*packets.FriendMessage, 0, len(friends))
+	for _, friend := range friends {
+		status := packets.FriendStatus_FRIEND_STATUS_ACCEPTED
+		if friend.Status == "pending" && friend.RequesterID == player.DbId {
+			status = packets.FriendStatus_FRIEND_STATUS_OUTGOING
+		} else if friend.Status == "pending" {
+			status = packets.FriendStatus_FRIEND_STATUS_INCOMING
+		}
+		entries = append(entries, friendEntry(client, friend.ID, friend.Name, status))
+	}
+	client.SocketSend(packets.NewFriendList(entries))
+}
+
+// Lets our player's online friends know they just logged in
+func notifyFriendsOnline(client server.ClientInterfacer, logger *log.Logger, player *objects.Player) {
+	friends, err := client.DbTx().Queries.ListFriends(client.DbTx().Ctx, player.DbId)
+	if err != nil {
+		logger.Printf("Error getting friends of %s: %v", player.Name, err)
+		return
+	}
+	for _, friend := range friends {
+		if friend.Status != "accepted" {
+			continue
+		}
+		if friendClient, online := findOnlineClient(client, friend.ID); online {
+			friendClient.SocketSendAs(packets.NewFriendOnline(player.Name), client.Id())
+		}
+	}
+}
+
+// Describes a friend along with what they're up to right now, which only accepted friends get to see. It goes by
+// which state the friend's client is in, without touching anything in it, since that's only for its own goroutine.
+func friendEntry(client server.ClientInterfacer, playerDbId int64, name string, status packets.FriendStatus) *packets.FriendMessage {
+	entry := &packets.FriendMessage{Name: name, Status: status}
+	if status != packets.FriendStatus_FRIEND_STATUS_ACCEPTED {
+		return entry
+	}
+
+	friendClient, online := findOnlineClient(client, playerDbId)
+	if !online {
+		return entry
+	}
+	switch friendClient.State().(type) {
+	case *Connected:
+		entry.Presence = packets.Presence_PRESENCE_LOBBY
+	case *BrowsingHiscores:
+		entry.Presence = packets.Presence_PRESENCE_BROWSING_HISCORES
+	case *InGame:
+		entry.Presence = packets.Presence_PRESENCE_IN_GAME
+		if player, exists := client.SharedGameObjects().Players.Get(friendClient.Id()); exists {
+			entry.Mass = player.Mass()
+		}
+	case *Dead:
+		entry.Presence = packets.Presence_PRESENCE_DEAD
+	}
+	return entry
+}
+
+// Finds the client ID the player with the given database ID is logged in with, if any
+func findOnlinePlayerByDbId(client server.ClientInterfacer, playerDbId int64) (uint64, bool) {
+	var foundId uint64
+	found := false
+	client.OnlinePlayers().ForEach(func(clientId uint64, player *objects.Player) {
+		if player.DbId == playerDbId {
+			foundId = clientId
+			found = true
+		}
+	})
+	return foundId, found
+}
+
+// Finds the client the player with the given database ID is logged in on, if any
+func findOnlineClient(client server.ClientInterfacer, playerDbId int64) (server.ClientInterfacer, bool) {
+	clientId, online := findOnlinePlayerByDbId(client, playerDbId)
+	if !online {
+		return nil, false
+	}
+	return client.Clients().Get(clientId)
+}
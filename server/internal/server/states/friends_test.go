@@ -0,0 +1,62 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"testing"
+)
+
+func TestFriendEntryPresence(t *testing.T) {
+	const friendDbId int64 = 7
+	tests := []struct {
+		name         string
+		online       bool
+		state        server.ClientStateHandler
+		wantPresence packets.Presence
+	}{
+		{"offline", false, nil, packets.Presence_PRESENCE_OFFLINE},
+		{"in the lobby", true, &Connected{}, packets.Presence_PRESENCE_LOBBY},
+		{"browsing hiscores", true, &BrowsingHiscores{}, packets.Presence_PRESENCE_BROWSING_HISCORES},
+		{"in game", true, &InGame{}, packets.Presence_PRESENCE_IN_GAME},
+		{"dead", true, &Dead{}, packets.Presence_PRESENCE_DEAD},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newFakeClient(t, 1)
+			friendClient := newFakeClient(t, 2)
+			friendClient.state = test.state
+			client.clients.Add(friendClient, 2)
+
+			friend := &objects.Player{Name: "Bob", DbId: friendDbId, Cells: objects.NewSharedCollection[*objects.Cell]()}
+			friend.Cells.Add(&objects.Cell{Radius: 10})
+			if test.online {
+				client.onlinePlayers.Add(friend, 2)
+			}
+			if _, inGame := test.state.(*InGame); inGame {
+				client.gameObjects.Players.Add(friend, 2)
+			}
+
+			entry := friendEntry(client, friendDbId, "Bob", packets.FriendStatus_FRIEND_STATUS_ACCEPTED)
+			if entry.Presence != test.wantPresence {
+				t.Errorf("presence = %v, want %v", entry.Presence, test.wantPresence)
+			}
+			wantMass := 0.0
+			if test.wantPresence == packets.Presence_PRESENCE_IN_GAME {
+				wantMass = friend.Mass()
+			}
+			if entry.Mass != wantMass {
+				t.Errorf("mass = %v, want %v", entry.Mass, wantMass)
+			}
+		})
+	}
+
+	// Only accepted friends get to see what each other are up to
+	client := newFakeClient(t, 1)
+	client.clients.Add(&fakeClient{id: 2, state: &InGame{}}, 2)
+	client.onlinePlayers.Add(&objects.Player{Name: "Bob", DbId: friendDbId}, 2)
+	entry := friendEntry(client, friendDbId, "Bob", packets.FriendStatus_FRIEND_STATUS_INCOMING)
+	if entry.Presence != packets.Presence_PRESENCE_OFFLINE {
+		t.Errorf("presence of a pending friend = %v, want offline", entry.Presence)
+	}
+}
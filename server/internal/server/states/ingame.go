@@ -1,27 +1,40 @@
 package states
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
-	"math/rand/v2"
-	"os"
 	"server/internal/server"
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
-	"strings"
 	"time"
-	"unicode"
 )
 
+// How quickly the launch boost of freshly split cells wears off
+const splitBoostFriction float64 = 4.0
+
+// How strongly a player's cells are pulled towards their common center each second
+const cellCohesion float64 = 1.0
+
+// Cells need to be this many times as massive as a virus to pop on it, smaller ones pass over it
+const virusPopMassRatio float64 = 1.3
+
+// The fraction of mass a cell loses to a virus when it has no room left to burst into more cells
+const virusMassPenalty float64 = 0.25
+
+// Cells shrunk below this by the safe zone are gone for good
+const minZoneCellRadius float64 = 10.0
+
 type InGame struct {
 	client                 server.ClientInterfacer
 	player                 *objects.Player
 	logger                 *log.Logger
 	cancelPlayerUpdateLoop context.CancelFunc
+	spawnedAt              time.Time
+	peakMass               float64
 }
 
 func (g *InGame) Name() string {
@@ -31,27 +44,67 @@ func (g *InGame) Name() string {
 func (g *InGame) SetClient(client server.ClientInterfacer) {
 	g.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
-	g.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	g.logger = server.NewClientLogger(loggingPrefix)
 }
 
 func (g *InGame) OnEnter() {
+	// Set the initial properties of the player, who starts out as a single cell
+	rules := g.client.Rules()
+	arena := &rules.Arena
+	x, y := objects.SpawnCoords(arena, rules.StartRadius, rules.SpawnTries, g.client.SharedGameObjects().Players, nil)
+	if partyX, partyY, found := g.partyPosition(); found {
+		// Party members play together, so spawn next to one of them instead
+		x, y = objects.SpawnCoordsNear(arena, rules.StartRadius, rules.SpawnTries, partyX, partyY, g.client.SharedGameObjects().Players)
+	}
+	g.player.Cells = objects.NewSharedCollection[*objects.Cell]()
+	g.player.Cells.Add(&objects.Cell{X: x, Y: y, Radius: rules.StartRadius})
+	g.player.Speed = g.client.Rules().SpeedForMass(g.player.Mass())
+
+	// Players keep their team when they respawn, so only newcomers need to be put in one
+	if g.client.Rules().Mode != server.ModeTeams {
+		g.player.Team = 0
+	} else if team := partyTeam(g.client, g.player); team >= 1 && team <= g.client.Rules().TeamCount {
+		g.player.Team = team
+	} else if g.player.Team < 1 || g.player.Team > g.client.Rules().TeamCount {
+		g.assignTeam()
+	}
+	g.spawnedAt = time.Now()
+	g.peakMass = g.player.Mass()
+
+	// Only share the player once it has a body, otherwise other clients could see it without any cells
 	g.logger.Printf("Adding Player to the %s shared collection", g.player.Name)
 	go g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
 
-	// Set the initial properties of the player
-	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
-	g.player.Speed = 150.0
-	g.player.Radius = 20.0
+	comeOnline(g.client, g.logger, g.player)
 
-	// Send the player's initial state to the client
+	// Let the client know where the edges of the world are, then send the player's initial state
+	g.client.SocketSend(packets.NewWorldInfo(arena))
 	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+	if round := g.client.Round(); round != nil {
+		g.client.SocketSend(round.PhaseMessage())
+	}
 
 	// Send the spores to the client in the background
 	// Added logic send in batches
 	go g.sendInitialSpores(20, 50*time.Millisecond)
+	go g.sendInitialViruses()
+	go g.sendInitialPowerUps()
 
 }
 
+// Makes the player the one the client is online as. Respawning players were already online, so only those who just
+// logged in need to catch up on the chat and tell their friends.
+func comeOnline(client server.ClientInterfacer, logger *log.Logger, player *objects.Player) {
+	_, wasOnline := client.OnlinePlayers().Get(client.Id())
+	client.OnlinePlayers().Add(player, client.Id())
+	if !wasOnline {
+		go sendChatHistory(client, logger, client.Rules().ChatReplayCount)
+		if !player.IsGuest() {
+			go notifyFriendsOnline(client, logger, player)
+		}
+	}
+}
+
 func (g *InGame) HandlerMessage(senderId uint64, message packets.Msg) {
 	switch message := message.(type) {
 	case *packets.Packet_Player:
@@ -66,8 +119,30 @@ func (g *InGame) HandlerMessage(senderId uint64, message packets.Msg) {
 		g.handlePlayerConsumed(senderId, message)
 	case *packets.Packet_Spore:
 		g.handleSpore(senderId, message)
+	case *packets.Packet_Virus:
+		g.handleVirus(senderId, message)
+	case *packets.Packet_VirusConsumed:
+		g.handleVirusConsumed(senderId, message)
+	case *packets.Packet_PowerUp:
+		g.handlePowerUp(senderId, message)
+	case *packets.Packet_PlayerEffect:
+		g.handlePlayerEffect(senderId, message)
+	case *packets.Packet_TeamScoreboard:
+		g.handleTeamScoreboard(senderId, message)
+	case *packets.Packet_RoundPhase:
+		g.handleRoundPhase(senderId, message)
+	case *packets.Packet_RoundResult:
+		g.handleRoundResult(senderId, message)
+	case *packets.Packet_ReportPlayer:
+		g.handleReportPlayer(senderId, message)
+	case *packets.Packet_SplitRequest:
+		g.handleSplitRequest(senderId, message)
+	case *packets.Packet_EjectMass:
+		g.handleEjectMass(senderId, message)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, message)
+	default:
+		handleLoggedInMessage(g.client, g.logger, g.player, senderId, message)
 	}
 }
 
@@ -88,25 +163,37 @@ func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_Sp
 		return
 	}
 
-	// Next, check if the spore is close enough to the player to be consumed
-	err = g.validatePlayerCloseToObject(spore.X, spore.Y, spore.Radius, 10)
+	// Next, find a cell which is close enough to the spore to consume it, and hasn't just dropped it
+	cellId, cell, err := g.getConsumingCell(message.SporeConsumed.CellId, func(cell *objects.Cell) error {
+		tolerance := g.client.Rules().ConsumeTolerance
+		err := validateCellCloseToObject(cell, spore.X, spore.Y, spore.Radius, tolerance)
+		if err != nil {
+			return err
+		}
+		return g.validatePlayerDropCooldown(cell, spore, tolerance)
+	})
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
 		return
 	}
-	// Check if players hasn't drop spore
-	err = g.validatePlayerDropCooldown(spore, 10)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+
+	// If we made it this far, the spore consumption is valid
+	g.consumeSpore(sporeId, spore, cellId, cell)
+}
+
+// Removes the spore, grows the cell, and broadcasts the event. Only whoever removes the spore gets its mass, since
+// another player, or the magnet on an earlier tick, could have consumed it since it was checked.
+func (g *InGame) consumeSpore(sporeId uint64, spore *objects.Spore, cellId uint64, cell *objects.Cell) {
+	if !g.client.SharedGameObjects().Spores.Remove(sporeId) {
 		return
 	}
-	// If we made it this far, the spore consumption is valid, so grow the player, remove the spore, and broadcast the event
-	sporeMass := radToMass(spore.Radius)
-	g.player.Radius = g.nextRadius(sporeMass)
 
-	go g.client.SharedGameObjects().Spores.Remove(sporeId)
+	sporeMass := objects.RadToMass(spore.Radius)
+	cell.Radius = nextRadius(cell.Radius, sporeMass)
+	g.updatePeakMass()
 
-	g.client.Broadcast(message)
+	// Let the other clients know which of our cells grew
+	g.client.Broadcast(packets.NewSporeConsumed(sporeId, cellId))
 	go g.syncPlayerBestScore()
 }
 
@@ -114,12 +201,19 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 	if senderId != g.client.Id() {
 		g.client.SocketSendAs(message, senderId)
 
-		if message.PlayerConsumed.PlayerId == g.client.Id() {
-			log.Println("Player was consumed, respawning")
-			g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
+		// We only die once the last of our cells has been consumed
+		if message.PlayerConsumed.PlayerId == g.client.Id() && g.player.Cells.Len() == 0 {
+			g.logger.Println("Player was consumed")
+			killerName := ""
+			if killer, exists := g.client.SharedGameObjects().Players.Get(senderId); exists {
+				killerName = killer.Name
+			}
+			g.client.SetState(&Dead{
+				player:       g.player,
+				killerId:     senderId,
+				killerName:   killerName,
+				survivalTime: time.Since(g.spawnedAt),
+				peakMass:     g.peakMass,
 			})
 		}
 
@@ -131,34 +225,144 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 
 	// First, check if the player exists
 	otherId := message.PlayerConsumed.PlayerId
+	if otherId == g.client.Id() {
+		g.logger.Println(errMsg + "player can't consume its own cells")
+		return
+	}
+	if round := g.client.Round(); round != nil && round.Phase() != server.RoundMatch {
+		g.logger.Println(errMsg + "players can't be consumed outside of a match")
+		return
+	}
 	other, err := g.getOtherPlayer(otherId)
 	if err != nil {
 		g.logger.Println(errMsg + err.Error())
 		return
 	}
-
-	// Next, check the other player's mass is smaller than our player's
-	ourMass := radToMass(g.player.Radius)
-	otherMass := radToMass(other.Radius)
-	if ourMass <= otherMass*1.5 {
-		g.logger.Printf(errMsg+"player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius)
+	if g.player.Team != 0 && other.Team == g.player.Team {
+		g.logger.Printf(errMsg+"player with ID %d is a teammate", otherId)
+		return
+	}
+	if other.HasEffect(objects.PowerUpShield) {
+		g.logger.Printf(errMsg+"player with ID %d is shielded", otherId)
 		return
 	}
 
-	// Finally, check if the player is close enough to the other to be consumed
-	err = g.validatePlayerCloseToObject(other.X, other.Y, other.Radius, 10)
-	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+	// If the client didn't say which of the other player's cells was consumed, consider all of them
+	otherCells := make(map[uint64]*objects.Cell)
+	if otherCellId := message.PlayerConsumed.CellId; otherCellId != 0 {
+		if otherCell, exists := other.Cells.Get(otherCellId); exists {
+			otherCells[otherCellId] = otherCell
+		}
+	} else {
+		other.Cells.ForEach(func(otherCellId uint64, otherCell *objects.Cell) {
+			otherCells[otherCellId] = otherCell
+		})
+	}
+
+	err = fmt.Errorf("no cells of player with ID %d to consume", otherId)
+	for otherCellId, otherCell := range otherCells {
+		// Next, find one of our cells which is massive enough and close enough to consume the other cell
+		otherMass := objects.RadToMass(otherCell.Radius)
+		var cellId uint64
+		var cell *objects.Cell
+		cellId, cell, err = g.getConsumingCell(message.PlayerConsumed.ByCellId, func(cell *objects.Cell) error {
+			if objects.RadToMass(cell.Radius) <= otherMass*g.client.Rules().ConsumeMassRatio {
+				return fmt.Errorf("cell not massive enough to consume the other cell (our radius: %f, other radius: %f)", cell.Radius, otherCell.Radius)
+			}
+			return validateCellCloseToObject(cell, otherCell.X, otherCell.Y, otherCell.Radius, g.client.Rules().ConsumeTolerance)
+		})
+		if err != nil {
+			continue
+		}
+
+		// If we made it this far, the consumption is valid, so remove the consumed cell, grow our cell, and broadcast
+		// the event. Someone else could have consumed the cell since we checked, in which case it's not ours to grow by.
+		if !other.Cells.Remove(otherCellId) {
+			err = fmt.Errorf("cell with ID %d was already consumed", otherCellId)
+			continue
+		}
+		cell.Radius = nextRadius(cell.Radius, otherMass)
+		g.updatePeakMass()
+
+		if other.Cells.Len() == 0 {
+			go g.client.SharedGameObjects().Players.Remove(otherId)
+		}
+
+		message.PlayerConsumed.CellId = otherCellId
+		message.PlayerConsumed.ByCellId = cellId
+		g.client.Broadcast(message)
+		go g.syncPlayerBestScore()
 		return
 	}
 
-	// If we made it this far, the player consumption is valid, so grow the player, remove the consumed other, and broadcast the event
-	g.player.Radius = g.nextRadius(otherMass)
+	g.logger.Println(errMsg + err.Error())
+}
+
+// Finds one of our cells which passes the given validation. If the client told us which cell it was, only that one is checked.
+func (g *InGame) getConsumingCell(cellId uint64, validate func(*objects.Cell) error) (uint64, *objects.Cell, error) {
+	if cellId != 0 {
+		cell, exists := g.player.Cells.Get(cellId)
+		if !exists {
+			return 0, nil, fmt.Errorf("cell with ID %d does not exist", cellId)
+		}
+		return cellId, cell, validate(cell)
+	}
+
+	var foundId uint64
+	var found *objects.Cell
+	err := errors.New("player has no cells")
+	g.player.Cells.ForEach(func(id uint64, cell *objects.Cell) {
+		if found != nil {
+			return
+		}
+		if err = validate(cell); err == nil {
+			foundId, found = id, cell
+		}
+	})
+	return foundId, found, err
+}
 
-	go g.client.SharedGameObjects().Players.Remove(otherId)
+// Where the largest cell of another party member in the game is, so the player can spawn next to them
+func (g *InGame) partyPosition() (float64, float64, bool) {
+	if g.player.Party == nil {
+		return 0, 0, false
+	}
+	var largest *objects.Cell
+	g.player.Party.Members.ForEach(func(memberId uint64, _ string) {
+		member, inGame := g.client.SharedGameObjects().Players.Get(memberId)
+		if memberId == g.client.Id() || !inGame {
+			return
+		}
+		member.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+			if largest == nil || cell.Radius > largest.Radius {
+				largest = cell
+			}
+		})
+	})
+	if largest == nil {
+		return 0, 0, false
+	}
+	return largest.X, largest.Y, true
+}
 
-	g.client.Broadcast(message)
-	go g.syncPlayerBestScore()
+// Puts the player in the team with the fewest players, to keep the teams balanced
+func (g *InGame) assignTeam() {
+	teamCount := g.client.Rules().TeamCount
+	playerCounts := make([]int, teamCount+1)
+	g.client.SharedGameObjects().Players.ForEach(func(_ uint64, player *objects.Player) {
+		if player.Team >= 1 && player.Team <= teamCount {
+			playerCounts[player.Team]++
+		}
+	})
+
+	team := 1
+	for candidate := 2; candidate <= teamCount; candidate++ {
+		if playerCounts[candidate] < playerCounts[team] {
+			team = candidate
+		}
+	}
+	g.player.Team = team
+	g.logger.Printf("Assigned %s to the %s team", g.player.Name, objects.TeamName(team))
 }
 
 func (g *InGame) getOtherPlayer(otherId uint64) (*objects.Player, error) {
@@ -169,10 +373,130 @@ func (g *InGame) getOtherPlayer(otherId uint64) (*objects.Player, error) {
 	return other, nil
 }
 
+func (g *InGame) handleSplitRequest(senderId uint64, _ *packets.Packet_SplitRequest) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received split request from another client (Id %d)", senderId)
+		return
+	}
+
+	rules := g.client.Rules()
+	cellCount := g.player.Cells.Len()
+	mergeAt := time.Now().Add(rules.MergeDelay)
+	dirX := math.Cos(g.player.Direction)
+	dirY := math.Sin(g.player.Direction)
+
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		mass := objects.RadToMass(cell.Radius)
+		if cellCount >= rules.MaxCells || mass < rules.MinSplitMass {
+			return
+		}
+
+		// Halve the cell, and launch the other half forward from just in front of it
+		cell.Radius = objects.MassToRad(mass / 2)
+		cell.MergeAt = mergeAt
+		g.player.Cells.Add(&objects.Cell{
+			X:       cell.X + dirX*2*cell.Radius,
+			Y:       cell.Y + dirY*2*cell.Radius,
+			Radius:  cell.Radius,
+			BoostX:  dirX * rules.SplitSpeed,
+			BoostY:  dirY * rules.SplitSpeed,
+			MergeAt: mergeAt,
+		})
+		cellCount++
+	})
+}
+
+func (g *InGame) handleEjectMass(senderId uint64, _ *packets.Packet_EjectMass) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received eject mass request from another client (Id %d)", senderId)
+		return
+	}
+
+	rules := g.client.Rules()
+	dirX := math.Cos(g.player.Direction)
+	dirY := math.Sin(g.player.Direction)
+
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		if objects.RadToMass(cell.Radius) < rules.MinEjectMass {
+			return
+		}
+
+		// Fire the spore from the edge of the cell, anyone can eat it once it's out (including us after the drop cooldown)
+		sporeRadius := objects.MassToRad(rules.EjectMass)
+		x, y := rules.Arena.Clamp(cell.X+dirX*(cell.Radius+sporeRadius), cell.Y+dirY*(cell.Radius+sporeRadius), sporeRadius)
+		spore := &objects.Spore{
+			X:         x,
+			Y:         y,
+			Radius:    sporeRadius,
+			DroppedBy: g.player,
+			DroppedAt: time.Now(),
+			VelX:      dirX * rules.EjectSpeed,
+			VelY:      dirY * rules.EjectSpeed,
+		}
+		cell.Radius = nextRadius(cell.Radius, -rules.EjectMass)
+
+		sporeId := g.client.SharedGameObjects().Spores.Add(spore)
+		g.client.Broadcast(packets.NewSpore(sporeId, spore))
+		go g.client.SocketSend(packets.NewSpore(sporeId, spore))
+	})
+}
+
 func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
 	g.client.SocketSendAs(message, senderId)
 }
 
+func (g *InGame) handleVirus(senderId uint64, message *packets.Packet_Virus) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handleVirusConsumed(senderId uint64, message *packets.Packet_VirusConsumed) {
+	// Viruses are popped by the server, so clients have no business telling us about it
+	if senderId == g.client.Id() {
+		g.logger.Println("Received virus consumed message from our own client, ignoring")
+		return
+	}
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handlePowerUp(senderId uint64, message *packets.Packet_PowerUp) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handlePlayerEffect(senderId uint64, message *packets.Packet_PlayerEffect) {
+	// Power-ups are collected by the server, so clients have no business telling us about it
+	if senderId == g.client.Id() {
+		g.logger.Println("Received player effect message from our own client, ignoring")
+		return
+	}
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handleTeamScoreboard(senderId uint64, message *packets.Packet_TeamScoreboard) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+func (g *InGame) handleRoundPhase(senderId uint64, message *packets.Packet_RoundPhase) {
+	g.client.SocketSendAs(message, senderId)
+}
+
+// Once a round is over, everyone still in the game starts the next one afresh
+func (g *InGame) handleRoundResult(senderId uint64, message *packets.Packet_RoundResult) {
+	// Only the round decides when it's over
+	if senderId != 0 {
+		g.logger.Printf("Received round result from another client (Id %d)", senderId)
+		return
+	}
+	g.client.SocketSendAs(message, senderId)
+	g.client.SetState(&InGame{player: respawnedPlayer(g.player)})
+}
+
+func (g *InGame) handleReportPlayer(senderId uint64, message *packets.Packet_ReportPlayer) {
+	if senderId != g.client.Id() {
+		return
+	}
+	submitReport(g.client, g.logger, g.player, message)
+}
+
 func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_PlayerDirection) {
 	if senderId == g.client.Id() {
 		g.player.Direction = message.PlayerDirection.Direction
@@ -187,16 +511,13 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 }
 
 func (g *InGame) handleChatMessage(senderId uint64, message *packets.Packet_Chat) {
-	msg, flag := filterMessageKeywords(message.Chat.Msg)
-	if flag {
-		message.Chat.Msg = msg
-	}
 	if senderId == g.client.Id() {
-		g.client.Broadcast(message)
+		sendChat(g.client, g.logger, g.player, message)
 	} else {
-		g.client.SocketSendAs(message, senderId)
+		receiveChat(g.client, g.player, senderId, message)
 	}
 }
+
 func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
 	if senderId == g.client.Id() {
 		g.logger.Println("Received player message from our own client, ignoring")
@@ -215,14 +536,19 @@ func (g *InGame) handleDisconnect(senderId uint64, message *packets.Packet_Disco
 }
 
 func (g *InGame) playerUpdateLoop(ctx context.Context) {
-	const delta float64 = 0.05
-	ticker := time.NewTicker(time.Duration(delta*1000) * time.Millisecond)
+	tickInterval := g.client.Rules().TickInterval
+	delta := tickInterval.Seconds()
+	ticker := time.NewTicker(tickInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
-			g.syncPlayer(delta)
+			tickInterval = server.FollowInterval(ticker, tickInterval, g.client.Rules().TickInterval)
+			delta = tickInterval.Seconds()
+			if !g.syncPlayer(delta) {
+				return
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -235,29 +561,79 @@ func (g *InGame) OnExit() {
 	}
 	g.client.SharedGameObjects().Players.Remove(g.client.Id())
 	g.syncPlayerBestScore()
+	if round := g.client.Round(); round != nil {
+		round.Eliminate(g.client.Id(), g.player)
+	}
 }
 
-func (g *InGame) syncPlayer(delta float64) {
-	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
-	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
+// Moves the player on by a tick, returning false once the player has died
+func (g *InGame) syncPlayer(delta float64) bool {
+	g.decayMass(delta)
 
-	g.player.X = newX
-	g.player.Y = newY
+	rules := g.client.Rules()
+	dirX := math.Cos(g.player.Direction)
+	dirY := math.Sin(g.player.Direction)
+	centerX, centerY := g.player.Center()
+	friction := math.Exp(-splitBoostFriction * delta)
 
-	// Drop a spore
-	probability := g.player.Radius / float64(server.MaxSpores*5)
-	if rand.Float64() < probability && g.player.Radius > 10 {
-		spore := &objects.Spore{
-			X:         g.player.X,
-			Y:         g.player.Y,
-			Radius:    min(5+g.player.Radius/50, 15),
-			DroppedBy: g.player,
-			DroppedAt: time.Now(),
+	speedMultiplier := 1.0
+	if g.player.HasEffect(objects.PowerUpSpeedBoost) {
+		speedMultiplier = rules.SpeedBoostMultiplier
+	}
+
+	// Heavier cells move slower, so the player as a whole moves at the mass-weighted average speed
+	var totalMass, totalMomentum float64
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		mass := objects.RadToMass(cell.Radius)
+		speed := rules.SpeedForMass(mass) * speedMultiplier
+		totalMass += mass
+		totalMomentum += mass * speed
+
+		cell.X += (speed*dirX + cell.BoostX) * delta
+		cell.Y += (speed*dirY + cell.BoostY) * delta
+		cell.BoostX *= friction
+		cell.BoostY *= friction
+
+		// Keep the cells together, otherwise they would drift apart forever
+		cell.X += (centerX - cell.X) * cellCohesion * delta
+		cell.Y += (centerY - cell.Y) * cellCohesion * delta
+	})
+
+	if totalMass > 0 {
+		g.player.Speed = totalMomentum / totalMass
+	}
+
+	g.resolveCellOverlaps()
+
+	// Nothing can leave the arena
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		cell.X, cell.Y = rules.Arena.Clamp(cell.X, cell.Y, cell.Radius)
+	})
+
+	if round := g.client.Round(); round != nil && round.Phase() == server.RoundMatch {
+		g.applyZoneDamage(delta, round.SafeZoneRadius())
+		if g.player.Cells.Len() == 0 {
+			// This runs on the update loop, so the client is left to change state on its own goroutine
+			g.logger.Println("Player was consumed by the safe zone")
+			dead := &Dead{
+				player:       g.player,
+				killerName:   "the safe zone",
+				survivalTime: time.Since(g.spawnedAt),
+				peakMass:     g.peakMass,
+			}
+			g.client.Enqueue(func() {
+				if g.client.State() == g {
+					g.client.SetState(dead)
+				}
+			})
+			return false
 		}
-		sporeId := g.client.SharedGameObjects().Spores.Add(spore)
-		g.client.Broadcast(packets.NewSpore(sporeId, spore))
-		go g.client.SocketSend(packets.NewSpore(sporeId, spore))
-		g.player.Radius = g.nextRadius(-radToMass(spore.Radius))
+	}
+
+	g.popOnViruses()
+	g.collectPowerUps()
+	if g.player.HasEffect(objects.PowerUpMagnet) {
+		g.pullSpores()
 	}
 
 	// Broadcast the updated player state
@@ -265,6 +641,215 @@ func (g *InGame) syncPlayer(delta float64) {
 	updatePacket := packets.NewPlayer(g.client.Id(), g.player)
 	g.client.Broadcast(updatePacket)
 	go g.client.SocketSend(updatePacket)
+	return true
+}
+
+// Shrinks players above the decay threshold, so staying huge has a cost
+func (g *InGame) decayMass(delta float64) {
+	rules := g.client.Rules()
+	mass := g.player.Mass()
+	if mass <= rules.DecayThreshold {
+		return
+	}
+
+	// Every cell loses its share, but never so much that the player drops below the threshold
+	loss := min(mass*rules.DecayRate*delta, mass-rules.DecayThreshold)
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		cellMass := objects.RadToMass(cell.Radius)
+		cell.Radius = nextRadius(cell.Radius, -loss*cellMass/mass)
+	})
+}
+
+// Shrinks any of our cells whose center is outside the safe zone, removing the ones which get too small
+func (g *InGame) applyZoneDamage(delta float64, zoneRadius float64) {
+	rate := g.client.Rules().ZoneDamageRate
+	g.player.Cells.ForEach(func(cellId uint64, cell *objects.Cell) {
+		if math.Hypot(cell.X, cell.Y) <= zoneRadius {
+			return
+		}
+		cell.Radius = nextRadius(cell.Radius, -objects.RadToMass(cell.Radius)*rate*delta)
+		if cell.Radius < minZoneCellRadius {
+			g.player.Cells.Remove(cellId)
+			g.client.Broadcast(packets.NewPlayerConsumed(g.client.Id(), cellId))
+		}
+	})
+}
+
+// Bursts any of our cells which are big enough to cover a virus
+func (g *InGame) popOnViruses() {
+	viruses := g.client.SharedGameObjects().Viruses
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		viruses.ForEach(func(virusId uint64, virus *objects.Virus) {
+			if objects.RadToMass(cell.Radius) < objects.RadToMass(virus.Radius)*virusPopMassRatio {
+				return
+			}
+			dx := cell.X - virus.X
+			dy := cell.Y - virus.Y
+			if dx*dx+dy*dy > cell.Radius*cell.Radius {
+				return
+			}
+
+			// Someone else may have just popped on the same virus
+			if !viruses.Remove(virusId) {
+				return
+			}
+			g.popCell(cell, objects.RadToMass(virus.Radius))
+
+			consumedPacket := packets.NewVirusConsumed(virusId)
+			g.client.Broadcast(consumedPacket)
+			go g.client.SocketSend(consumedPacket)
+		})
+	})
+}
+
+// Bursts the cell into fragments flying off in all directions, or shrinks it if the player can't have any more cells
+func (g *InGame) popCell(cell *objects.Cell, virusMass float64) {
+	rules := g.client.Rules()
+	fragments := min(rules.VirusPopFragments, rules.MaxCells-g.player.Cells.Len()+1)
+	if fragments <= 1 {
+		cell.Radius = nextRadius(cell.Radius, -objects.RadToMass(cell.Radius)*virusMassPenalty)
+		return
+	}
+
+	mass := objects.RadToMass(cell.Radius) + virusMass
+	mergeAt := time.Now().Add(rules.MergeDelay)
+	cell.Radius = objects.MassToRad(mass / float64(fragments))
+	cell.MergeAt = mergeAt
+
+	for i := 1; i < fragments; i++ {
+		angle := g.player.Direction + 2*math.Pi*float64(i)/float64(fragments)
+		dirX := math.Cos(angle)
+		dirY := math.Sin(angle)
+		g.player.Cells.Add(&objects.Cell{
+			X:       cell.X + dirX*2*cell.Radius,
+			Y:       cell.Y + dirY*2*cell.Radius,
+			Radius:  cell.Radius,
+			BoostX:  dirX * rules.SplitSpeed,
+			BoostY:  dirY * rules.SplitSpeed,
+			MergeAt: mergeAt,
+		})
+	}
+	g.updatePeakMass()
+}
+
+// Grants the effect of any power-up one of our cells is touching
+func (g *InGame) collectPowerUps() {
+	powerUps := g.client.SharedGameObjects().PowerUps
+	duration := g.client.Rules().PowerUpDuration
+	g.player.Cells.ForEach(func(_ uint64, cell *objects.Cell) {
+		powerUps.ForEach(func(powerUpId uint64, powerUp *objects.PowerUp) {
+			dx := cell.X - powerUp.X
+			dy := cell.Y - powerUp.Y
+			reach := cell.Radius + powerUp.Radius
+			if dx*dx+dy*dy > reach*reach {
+				return
+			}
+
+			// Someone else may have just collected the same power-up
+			if !powerUps.Remove(powerUpId) {
+				return
+			}
+			g.player.GrantEffect(powerUp.Kind, duration)
+			g.logger.Printf("Collected power-up %d of kind %d", powerUpId, powerUp.Kind)
+
+			effectPacket := packets.NewPlayerEffect(powerUp.Kind, duration, powerUpId)
+			g.client.Broadcast(effectPacket)
+			go g.client.SocketSend(effectPacket)
+		})
+	})
+}
+
+// Draws nearby spores towards our closest cell, and consumes the ones which reach it
+func (g *InGame) pullSpores() {
+	rules := g.client.Rules()
+	g.client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		var closestId uint64
+		var closest *objects.Cell
+		closestDistSq := math.Inf(1)
+		g.player.Cells.ForEach(func(cellId uint64, cell *objects.Cell) {
+			dx := cell.X - spore.X
+			dy := cell.Y - spore.Y
+			if distSq := dx*dx + dy*dy; distSq < closestDistSq {
+				closestId, closest, closestDistSq = cellId, cell, distSq
+			}
+		})
+		if closest == nil {
+			return
+		}
+
+		reach := closest.Radius + rules.MagnetRange
+		if closestDistSq > reach*reach || g.validatePlayerDropCooldown(closest, spore, rules.ConsumeTolerance) != nil {
+			return
+		}
+
+		dist := math.Sqrt(closestDistSq)
+		if dist < closest.Radius {
+			g.consumeSpore(sporeId, spore, closestId, closest)
+			return
+		}
+
+		// The hub moves it from here, and lets everyone know where it's got to
+		spore.VelX = (closest.X - spore.X) / dist * rules.MagnetSpeed
+		spore.VelY = (closest.Y - spore.Y) / dist * rules.MagnetSpeed
+	})
+}
+
+// Pushes apart the player's cells which aren't allowed to merge yet, and merges the ones which are
+func (g *InGame) resolveCellOverlaps() {
+	type idCell struct {
+		id   uint64
+		cell *objects.Cell
+	}
+	cells := make([]idCell, 0, g.player.Cells.Len())
+	g.player.Cells.ForEach(func(id uint64, cell *objects.Cell) {
+		cells = append(cells, idCell{id, cell})
+	})
+
+	now := time.Now()
+	merged := make(map[uint64]bool)
+	for i, a := range cells {
+		for _, b := range cells[i+1:] {
+			if merged[a.id] || merged[b.id] {
+				continue
+			}
+
+			dx := b.cell.X - a.cell.X
+			dy := b.cell.Y - a.cell.Y
+			dist := math.Hypot(dx, dy)
+
+			if now.After(a.cell.MergeAt) && now.After(b.cell.MergeAt) {
+				// Merge once the smaller cell's center is inside the bigger one
+				if dist < max(a.cell.Radius, b.cell.Radius) {
+					big, small := a, b
+					if small.cell.Radius > big.cell.Radius {
+						big, small = small, big
+					}
+					big.cell.Radius = nextRadius(big.cell.Radius, objects.RadToMass(small.cell.Radius))
+					g.player.Cells.Remove(small.id)
+					merged[small.id] = true
+				}
+				continue
+			}
+
+			overlap := a.cell.Radius + b.cell.Radius - dist
+			if overlap <= 0 {
+				continue
+			}
+			if dist == 0 {
+				dx, dy, dist = math.Cos(g.player.Direction), math.Sin(g.player.Direction), 1
+			}
+
+			// The lighter cell gets pushed further than the heavier one
+			massA := objects.RadToMass(a.cell.Radius)
+			massB := objects.RadToMass(b.cell.Radius)
+			pushA := overlap * massB / (massA + massB)
+			pushB := overlap * massA / (massA + massB)
+			a.cell.X -= dx / dist * pushA
+			a.cell.Y -= dy / dist * pushA
+			b.cell.X += dx / dist * pushB
+			b.cell.Y += dy / dist * pushB
+		}
+	}
 }
 
 func (g *InGame) sendInitialSpores(batchSize int, delayBetweenBatches time.Duration) {
@@ -285,6 +870,18 @@ func (g *InGame) sendInitialSpores(batchSize int, delayBetweenBatches time.Durat
 	}
 }
 
+func (g *InGame) sendInitialViruses() {
+	g.client.SharedGameObjects().Viruses.ForEach(func(virusId uint64, virus *objects.Virus) {
+		g.client.SocketSend(packets.NewVirus(virusId, virus))
+	})
+}
+
+func (g *InGame) sendInitialPowerUps() {
+	g.client.SharedGameObjects().PowerUps.ForEach(func(powerUpId uint64, powerUp *objects.PowerUp) {
+		g.client.SocketSend(packets.NewPowerUp(powerUpId, powerUp))
+	})
+}
+
 func (g *InGame) getSpore(sporeId uint64) (*objects.Spore, error) {
 	spore, exists := g.client.SharedGameObjects().Spores.Get(sporeId)
 	if !exists {
@@ -293,22 +890,22 @@ func (g *InGame) getSpore(sporeId uint64) (*objects.Spore, error) {
 	return spore, nil
 }
 
-func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float64) error {
-	realDX := g.player.X - objX
-	realDY := g.player.Y - objY
+func validateCellCloseToObject(cell *objects.Cell, objX, objY, objRadius, buffer float64) error {
+	realDX := cell.X - objX
+	realDY := cell.Y - objY
 	realDistSq := realDX*realDX + realDY*realDY
 
-	thresholdDist := g.player.Radius + buffer + objRadius
+	thresholdDist := cell.Radius + buffer + objRadius
 	thresholdDistSq := thresholdDist * thresholdDist
 
 	if realDistSq > thresholdDistSq {
-		return fmt.Errorf("player is too far from the object (distSq: %f, thresholdSq: %f)", realDistSq, thresholdDistSq)
+		return fmt.Errorf("cell is too far from the object (distSq: %f, thresholdSq: %f)", realDistSq, thresholdDistSq)
 	}
 	return nil
 }
 
-func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64) error {
-	minAcceptableDistance := spore.Radius + g.player.Radius - buffer
+func (g *InGame) validatePlayerDropCooldown(cell *objects.Cell, spore *objects.Spore, buffer float64) error {
+	minAcceptableDistance := spore.Radius + cell.Radius - buffer
 	minAcceptableTime := time.Duration(minAcceptableDistance/g.player.Speed*1000) * time.Millisecond
 	if spore.DroppedBy == g.player && time.Since(spore.DroppedAt) < minAcceptableTime {
 		return fmt.Errorf("player dropped the spore too recently (time since drop: %v, min acceptable time: %v)", time.Since(spore.DroppedAt), minAcceptableTime)
@@ -316,24 +913,24 @@ func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64
 	return nil
 }
 
-func radToMass(radius float64) float64 {
-	return math.Pi * radius * radius
-}
-
-func massToRad(mass float64) float64 {
-	return math.Sqrt(mass / math.Pi)
+func nextRadius(radius float64, massDiff float64) float64 {
+	oldMass := objects.RadToMass(radius)
+	newMass := oldMass + massDiff
+	return objects.MassToRad(newMass)
 }
 
-func (g *InGame) nextRadius(massDiff float64) float64 {
-	oldMass := radToMass(g.player.Radius)
-	newMass := oldMass + massDiff
-	return massToRad(newMass)
+func (g *InGame) updatePeakMass() {
+	g.peakMass = max(g.peakMass, g.player.Mass())
 }
 
 func (g *InGame) syncPlayerBestScore() {
-	currentScore := int64(math.Round(radToMass(g.player.Radius)))
+	currentScore := int64(math.Round(g.player.Mass()))
 	if currentScore > g.player.BestScore {
+		// Guests keep their best score for the session in case they register
 		g.player.BestScore = currentScore
+		if g.player.IsGuest() {
+			return
+		}
 		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
 			ID:        g.player.DbId,
 			BestScore: g.player.BestScore,
@@ -344,63 +941,3 @@ func (g *InGame) syncPlayerBestScore() {
 	}
 
 }
-
-func normalizeWord(word string) string {
-	trimmedWord := strings.TrimFunc(word, func(r rune) bool {
-		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
-	})
-	return strings.ToLower(trimmedWord)
-}
-
-func filterMessageKeywords(msg string) (string, bool) {
-	var bannedWordsPath = "/gameserver/banned_words.txt"
-	set := make(map[string]struct{})
-
-	// Read from file
-	if bannedWordsPath != "" {
-		file, err := os.Open(bannedWordsPath)
-		if err != nil {
-			return "failed to open", false
-		}
-		defer file.Close()
-
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			word := strings.ToLower(strings.TrimSpace(scanner.Text()))
-			if word != "" {
-				set[word] = struct{}{}
-			}
-		}
-		if err := scanner.Err(); err != nil {
-			return "failed to read", false
-		}
-	}
-
-	// Add default banned words
-	// defaultBannedWords := []string{"idiot", "stupid", "heck", "v1ol3ntword"}
-	// for _, bw := range defaultBannedWords {
-	// 	set[strings.ToLower(bw)] = struct{}{}
-	// }
-
-	originalWords := strings.Fields(msg)
-	if len(originalWords) == 0 {
-		return msg, false
-	}
-
-	modified := false
-	outputWords := make([]string, len(originalWords))
-	copy(outputWords, originalWords)
-
-	for i, currentWord := range originalWords {
-		normalized := normalizeWord(currentWord)
-		if _, isBanned := set[normalized]; isBanned {
-			outputWords[i] = strings.Repeat("*", len(currentWord))
-			modified = true
-		}
-	}
-
-	if modified {
-		return strings.Join(outputWords, " "), true
-	}
-	return msg, false
-}
@@ -0,0 +1,171 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+)
+
+// The most players a party can have, including the leader
+const maxPartySize int = 6
+
+// Handles the party packets our own client sends from any of the logged in states, reporting whether it was one
+func handlePartyMessage(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, senderId uint64, message packets.Msg) bool {
+	if senderId != client.Id() {
+		return false
+	}
+
+	switch message := message.(type) {
+	case *packets.Packet_PartyInvite:
+		inviteToParty(client, logger, player, message.PartyInvite.Name)
+	case *packets.Packet_PartyJoin:
+		joinParty(client, logger, player, message.PartyJoin.Name)
+	case *packets.Packet_PartyLeave:
+		if player.Party == nil {
+			client.SocketSend(packets.NewDenyResponse("You're not in a party"))
+			return true
+		}
+		logger.Printf("%s left their party", player.Name)
+		server.LeaveParty(client.Clients(), client.Id(), player)
+	case *packets.Packet_PartyKick:
+		kickFromParty(client, logger, player, message.PartyKick.Name)
+	default:
+		return false
+	}
+	return true
+}
+
+// Invites another online player to the party, starting a new one with us as the leader if we're not in one yet
+func inviteToParty(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	targetId, online := findOnlinePlayer(client, name)
+	if !online {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s is not online", name)))
+		return
+	}
+	if targetId == client.Id() {
+		client.SocketSend(packets.NewDenyResponse("You can't invite yourself"))
+		return
+	}
+	target, exists := client.OnlinePlayers().Get(targetId)
+	targetClient, connected := client.Clients().Get(targetId)
+	if !exists || !connected {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s is not online", name)))
+		return
+	}
+
+	party := player.Party
+	if party == nil {
+		party = objects.NewParty(client.Id(), player.Name)
+		player.Party = party
+		logger.Printf("%s started a party", player.Name)
+		server.SyncParty(client.Clients(), party)
+	} else if party.LeaderId() != client.Id() {
+		client.SocketSend(packets.NewDenyResponse("Only the party leader can invite players"))
+		return
+	}
+	if target.Party == party {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s is already in your party", target.Name)))
+		return
+	}
+	if party.Members.Len() >= maxPartySize {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Parties can't have more than %d players", maxPartySize)))
+		return
+	}
+
+	party.Invited.Add(target.Name, targetId)
+	targetClient.SocketSendAs(packets.NewPartyInvite(player.Name), client.Id())
+	client.SocketSend(packets.NewOkResponse())
+}
+
+// Joins the party of the player who invited us, leaving the one we're in first
+func joinParty(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	var party *objects.Party
+	if inviterId, online := findOnlinePlayer(client, name); online {
+		if inviter, exists := client.OnlinePlayers().Get(inviterId); exists {
+			party = inviter.Party
+		}
+	}
+	if party == nil {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("No party invite from %s", name)))
+		return
+	}
+	if _, invited := party.Invited.Get(client.Id()); !invited {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("No party invite from %s", name)))
+		return
+	}
+	if party.Members.Len() >= maxPartySize {
+		client.SocketSend(packets.NewDenyResponse("That party is full"))
+		return
+	}
+
+	server.LeaveParty(client.Clients(), client.Id(), player)
+	party.Invited.Remove(client.Id())
+	party.Members.Add(player.Name, client.Id())
+	player.Party = party
+	logger.Printf("%s joined the party of %s", player.Name, name)
+	server.SyncParty(client.Clients(), party)
+}
+
+func kickFromParty(client server.ClientInterfacer, logger *log.Logger, player *objects.Player, name string) {
+	party := player.Party
+	if party == nil || party.LeaderId() != client.Id() {
+		client.SocketSend(packets.NewDenyResponse("Only the party leader can kick players"))
+		return
+	}
+
+	var memberId uint64
+	party.Members.ForEach(func(id uint64, memberName string) {
+		if strings.EqualFold(memberName, name) {
+			memberId = id
+		}
+	})
+	if memberId == 0 {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("%s is not in your party", name)))
+		return
+	}
+	if memberId == client.Id() {
+		client.SocketSend(packets.NewDenyResponse("You can't kick yourself - leave the party instead"))
+		return
+	}
+
+	server.RemoveFromParty(client.Clients(), memberId, party)
+	logger.Printf("%s kicked %s from their party", player.Name, name)
+}
+
+// The team the rest of the party is playing for, preferably the leader's, or 0 if they aren't in one
+func partyTeam(client server.ClientInterfacer, player *objects.Player) int {
+	if player.Party == nil {
+		return 0
+	}
+	leaderId := player.Party.LeaderId()
+	if leader, online := client.OnlinePlayers().Get(leaderId); online && leaderId != client.Id() && leader.Team != 0 {
+		return leader.Team
+	}
+
+	team := 0
+	player.Party.Members.ForEach(func(memberId uint64, _ string) {
+		if team != 0 || memberId == client.Id() {
+			return
+		}
+		if member, online := client.OnlinePlayers().Get(memberId); online {
+			team = member.Team
+		}
+	})
+	return team
+}
+
+// Brings along the dead members of the leader's party when the leader respawns. Each member gets the request in
+// their own queue and respawns on their own goroutine.
+func respawnParty(client server.ClientInterfacer, player *objects.Player) {
+	if player.Party == nil || player.Party.LeaderId() != client.Id() {
+		return
+	}
+	player.Party.Members.ForEach(func(memberId uint64, _ string) {
+		if memberId != client.Id() {
+			client.PassToPeer(packets.NewRespawnRequest(), memberId)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package states
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strings"
+	"time"
+)
+
+// Reports are cut off at this many characters
+const maxReportTextLength int = 500
+
+// How many of the reported player's latest chat messages are saved with the report
+const reportChatSnapshotSize int64 = 20
+
+// How long players have to wait between reports
+const minReportInterval time.Duration = 30 * time.Second
+
+// Saves a report against another player for the moderators to look at, along with a snapshot of their recent chat
+// and stats. Only registered players can report, at most once every so often and once per player until the moderators
+// have looked at it, so the reports can't be flooded. The reporter is nil for clients who aren't logged in.
+func submitReport(client server.ClientInterfacer, logger *log.Logger, reporter *objects.Player, message *packets.Packet_ReportPlayer) {
+	if reporter == nil || reporter.IsGuest() {
+		client.SocketSend(packets.NewDenyResponse("Only registered players can report other players"))
+		return
+	}
+	if wait := time.Until(reporter.LastReportAt.Add(minReportInterval)); wait > 0 {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("Please wait another %v before reporting again", wait.Round(time.Second))))
+		return
+	}
+
+	dbTx := client.DbTx()
+	target, err := findSavedPlayer(client, message.ReportPlayer.Name)
+	if err != nil {
+		client.SocketSend(packets.NewDenyResponse("No player found with that name"))
+		return
+	}
+	if reporter.DbId == target.ID {
+		client.SocketSend(packets.NewDenyResponse("You can't report yourself"))
+		return
+	}
+	reporterId := sql.NullInt64{Int64: reporter.DbId, Valid: true}
+
+	openReports, err := dbTx.Queries.CountOpenReports(dbTx.Ctx, db.CountOpenReportsParams{
+		ReporterPlayerID: reporterId,
+		TargetPlayerID:   target.ID,
+	})
+	if err != nil {
+		logger.Printf("Error counting reports against player %s: %v", target.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to send the report - please try again later"))
+		return
+	}
+	if openReports > 0 {
+		client.SocketSend(packets.NewDenyResponse(fmt.Sprintf("You've already reported %s - the moderators will look at it soon", target.Name)))
+		return
+	}
+
+	text := message.ReportPlayer.Text
+	if runes := []rune(text); len(runes) > maxReportTextLength {
+		text = string(runes[:maxReportTextLength])
+	}
+
+	chat, err := dbTx.Queries.SearchPlayerChatMessages(dbTx.Ctx, db.SearchPlayerChatMessagesParams{
+		PlayerID: target.ID,
+		Text:     "%",
+		Limit:    reportChatSnapshotSize,
+	})
+	if err != nil {
+		logger.Printf("Error getting chat of player %s for a report: %v", target.Name, err)
+	}
+	chatLines := make([]string, 0, len(chat))
+	for i := len(chat) - 1; i >= 0; i-- {
+		chatLines = append(chatLines, fmt.Sprintf("[%s] %s", chat[i].SentAt.Format(time.DateTime), chat[i].Text))
+	}
+
+	// The current mass is only known if they're playing right now
+	mass := 0.0
+	if targetId, online := findOnlinePlayer(client, target.Name); online {
+		if player, inGame := client.SharedGameObjects().Players.Get(targetId); inGame {
+			mass = player.Mass()
+		}
+	}
+
+	report, err := dbTx.Queries.CreateReport(dbTx.Ctx, db.CreateReportParams{
+		ReporterPlayerID: reporterId,
+		TargetPlayerID:   target.ID,
+		Reason:           int64(message.ReportPlayer.Reason),
+		Text:             text,
+		ChatSnapshot:     strings.Join(chatLines, "\n"),
+		BestScore:        target.BestScore,
+		Mass:             mass,
+	})
+	if err != nil {
+		logger.Printf("Error saving report against player %s: %v", target.Name, err)
+		client.SocketSend(packets.NewDenyResponse("Failed to send the report - please try again later"))
+		return
+	}
+
+	reporter.LastReportAt = time.Now()
+	logger.Printf("Report %d submitted against %s for %v", report.ID, target.Name, message.ReportPlayer.Reason)
+	client.SocketSend(packets.NewOkResponse())
+}
@@ -0,0 +1,30 @@
+package server
+
+// A fixed number of goroutines taking turns at queued jobs, so slow work like password hashing can't tie up every
+// CPU however much of it arrives at once
+type WorkerPool struct {
+	jobs chan func()
+}
+
+// Starts the workers, which keep running for as long as the server does
+func NewWorkerPool(workers int, queueSize int) *WorkerPool {
+	pool := &WorkerPool{jobs: make(chan func(), queueSize)}
+	for range workers {
+		go func() {
+			for job := range pool.jobs {
+				job()
+			}
+		}()
+	}
+	return pool
+}
+
+// Queues the job for the next free worker, or returns false if the queue is full
+func (p *WorkerPool) Submit(job func()) bool {
+	select {
+	case p.jobs <- job:
+		return true
+	default:
+		return false
+	}
+}
@@ -21,9 +21,377 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ArenaShape int32
+
+const (
+	ArenaShape_ARENA_SHAPE_RECTANGLE ArenaShape = 0
+	ArenaShape_ARENA_SHAPE_CIRCLE    ArenaShape = 1
+)
+
+// Enum value maps for ArenaShape.
+var (
+	ArenaShape_name = map[int32]string{
+		0: "ARENA_SHAPE_RECTANGLE",
+		1: "ARENA_SHAPE_CIRCLE",
+	}
+	ArenaShape_value = map[string]int32{
+		"ARENA_SHAPE_RECTANGLE": 0,
+		"ARENA_SHAPE_CIRCLE":    1,
+	}
+)
+
+func (x ArenaShape) Enum() *ArenaShape {
+	p := new(ArenaShape)
+	*p = x
+	return p
+}
+
+func (x ArenaShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArenaShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[0].Descriptor()
+}
+
+func (ArenaShape) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[0]
+}
+
+func (x ArenaShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArenaShape.Descriptor instead.
+func (ArenaShape) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{0}
+}
+
+type PowerUpKind int32
+
+const (
+	PowerUpKind_POWER_UP_KIND_SPEED_BOOST PowerUpKind = 0
+	PowerUpKind_POWER_UP_KIND_SHIELD      PowerUpKind = 1
+	PowerUpKind_POWER_UP_KIND_MAGNET      PowerUpKind = 2
+)
+
+// Enum value maps for PowerUpKind.
+var (
+	PowerUpKind_name = map[int32]string{
+		0: "POWER_UP_KIND_SPEED_BOOST",
+		1: "POWER_UP_KIND_SHIELD",
+		2: "POWER_UP_KIND_MAGNET",
+	}
+	PowerUpKind_value = map[string]int32{
+		"POWER_UP_KIND_SPEED_BOOST": 0,
+		"POWER_UP_KIND_SHIELD":      1,
+		"POWER_UP_KIND_MAGNET":      2,
+	}
+)
+
+func (x PowerUpKind) Enum() *PowerUpKind {
+	p := new(PowerUpKind)
+	*p = x
+	return p
+}
+
+func (x PowerUpKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowerUpKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[1].Descriptor()
+}
+
+func (PowerUpKind) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[1]
+}
+
+func (x PowerUpKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowerUpKind.Descriptor instead.
+func (PowerUpKind) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{1}
+}
+
+type RoundPhase int32
+
+const (
+	RoundPhase_ROUND_PHASE_LOBBY     RoundPhase = 0
+	RoundPhase_ROUND_PHASE_COUNTDOWN RoundPhase = 1
+	RoundPhase_ROUND_PHASE_MATCH     RoundPhase = 2
+)
+
+// Enum value maps for RoundPhase.
+var (
+	RoundPhase_name = map[int32]string{
+		0: "ROUND_PHASE_LOBBY",
+		1: "ROUND_PHASE_COUNTDOWN",
+		2: "ROUND_PHASE_MATCH",
+	}
+	RoundPhase_value = map[string]int32{
+		"ROUND_PHASE_LOBBY":     0,
+		"ROUND_PHASE_COUNTDOWN": 1,
+		"ROUND_PHASE_MATCH":     2,
+	}
+)
+
+func (x RoundPhase) Enum() *RoundPhase {
+	p := new(RoundPhase)
+	*p = x
+	return p
+}
+
+func (x RoundPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[2].Descriptor()
+}
+
+func (RoundPhase) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[2]
+}
+
+func (x RoundPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundPhase.Descriptor instead.
+func (RoundPhase) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{2}
+}
+
+type ChatChannel int32
+
+const (
+	ChatChannel_CHAT_CHANNEL_GLOBAL       ChatChannel = 0
+	ChatChannel_CHAT_CHANNEL_ARENA        ChatChannel = 1
+	ChatChannel_CHAT_CHANNEL_TEAM         ChatChannel = 2
+	ChatChannel_CHAT_CHANNEL_WHISPER      ChatChannel = 3
+	ChatChannel_CHAT_CHANNEL_ANNOUNCEMENT ChatChannel = 4
+	ChatChannel_CHAT_CHANNEL_PARTY        ChatChannel = 5
+)
+
+// Enum value maps for ChatChannel.
+var (
+	ChatChannel_name = map[int32]string{
+		0: "CHAT_CHANNEL_GLOBAL",
+		1: "CHAT_CHANNEL_ARENA",
+		2: "CHAT_CHANNEL_TEAM",
+		3: "CHAT_CHANNEL_WHISPER",
+		4: "CHAT_CHANNEL_ANNOUNCEMENT",
+		5: "CHAT_CHANNEL_PARTY",
+	}
+	ChatChannel_value = map[string]int32{
+		"CHAT_CHANNEL_GLOBAL":       0,
+		"CHAT_CHANNEL_ARENA":        1,
+		"CHAT_CHANNEL_TEAM":         2,
+		"CHAT_CHANNEL_WHISPER":      3,
+		"CHAT_CHANNEL_ANNOUNCEMENT": 4,
+		"CHAT_CHANNEL_PARTY":        5,
+	}
+)
+
+func (x ChatChannel) Enum() *ChatChannel {
+	p := new(ChatChannel)
+	*p = x
+	return p
+}
+
+func (x ChatChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[3].Descriptor()
+}
+
+func (ChatChannel) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[3]
+}
+
+func (x ChatChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannel.Descriptor instead.
+func (ChatChannel) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{3}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_OTHER          ReportReason = 0
+	ReportReason_REPORT_REASON_CHEATING       ReportReason = 1
+	ReportReason_REPORT_REASON_HARASSMENT     ReportReason = 2
+	ReportReason_REPORT_REASON_OFFENSIVE_NAME ReportReason = 3
+	ReportReason_REPORT_REASON_SPAM           ReportReason = 4
+	ReportReason_REPORT_REASON_GRIEFING       ReportReason = 5
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_OTHER",
+		1: "REPORT_REASON_CHEATING",
+		2: "REPORT_REASON_HARASSMENT",
+		3: "REPORT_REASON_OFFENSIVE_NAME",
+		4: "REPORT_REASON_SPAM",
+		5: "REPORT_REASON_GRIEFING",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_OTHER":          0,
+		"REPORT_REASON_CHEATING":       1,
+		"REPORT_REASON_HARASSMENT":     2,
+		"REPORT_REASON_OFFENSIVE_NAME": 3,
+		"REPORT_REASON_SPAM":           4,
+		"REPORT_REASON_GRIEFING":       5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[4].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[4]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{4}
+}
+
+type FriendStatus int32
+
+const (
+	FriendStatus_FRIEND_STATUS_ACCEPTED FriendStatus = 0
+	FriendStatus_FRIEND_STATUS_INCOMING FriendStatus = 1
+	FriendStatus_FRIEND_STATUS_OUTGOING FriendStatus = 2
+)
+
+// Enum value maps for FriendStatus.
+var (
+	FriendStatus_name = map[int32]string{
+		0: "FRIEND_STATUS_ACCEPTED",
+		1: "FRIEND_STATUS_INCOMING",
+		2: "FRIEND_STATUS_OUTGOING",
+	}
+	FriendStatus_value = map[string]int32{
+		"FRIEND_STATUS_ACCEPTED": 0,
+		"FRIEND_STATUS_INCOMING": 1,
+		"FRIEND_STATUS_OUTGOING": 2,
+	}
+)
+
+func (x FriendStatus) Enum() *FriendStatus {
+	p := new(FriendStatus)
+	*p = x
+	return p
+}
+
+func (x FriendStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[5].Descriptor()
+}
+
+func (FriendStatus) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[5]
+}
+
+func (x FriendStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendStatus.Descriptor instead.
+func (FriendStatus) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{5}
+}
+
+type Presence int32
+
+const (
+	Presence_PRESENCE_OFFLINE           Presence = 0
+	Presence_PRESENCE_LOBBY             Presence = 1
+	Presence_PRESENCE_IN_GAME           Presence = 2
+	Presence_PRESENCE_BROWSING_HISCORES Presence = 3
+	Presence_PRESENCE_DEAD              Presence = 4
+)
+
+// Enum value maps for Presence.
+var (
+	Presence_name = map[int32]string{
+		0: "PRESENCE_OFFLINE",
+		1: "PRESENCE_LOBBY",
+		2: "PRESENCE_IN_GAME",
+		3: "PRESENCE_BROWSING_HISCORES",
+		4: "PRESENCE_DEAD",
+	}
+	Presence_value = map[string]int32{
+		"PRESENCE_OFFLINE":           0,
+		"PRESENCE_LOBBY":             1,
+		"PRESENCE_IN_GAME":           2,
+		"PRESENCE_BROWSING_HISCORES": 3,
+		"PRESENCE_DEAD":              4,
+	}
+)
+
+func (x Presence) Enum() *Presence {
+	p := new(Presence)
+	*p = x
+	return p
+}
+
+func (x Presence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Presence) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[6].Descriptor()
+}
+
+func (Presence) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[6]
+}
+
+func (x Presence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Presence.Descriptor instead.
+func (Presence) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{6}
+}
+
 type ChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Channel       ChatChannel            `protobuf:"varint,2,opt,name=channel,proto3,enum=packets.ChatChannel" json:"channel,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	SenderName    string                 `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Replayed      bool                   `protobuf:"varint,5,opt,name=replayed,proto3" json:"replayed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,6 +433,34 @@ func (x *ChatMessage) GetMsg() string {
 	return ""
 }
 
+func (x *ChatMessage) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_GLOBAL
+}
+
+func (x *ChatMessage) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
 type IdMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -221,6 +617,42 @@ func (x *RegisterRequestMessage) GetColor() int32 {
 	return 0
 }
 
+type GuestLoginMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestLoginMessage) Reset() {
+	*x = GuestLoginMessage{}
+	mi := &file_packets_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestLoginMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestLoginMessage) ProtoMessage() {}
+
+func (x *GuestLoginMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestLoginMessage.ProtoReflect.Descriptor instead.
+func (*GuestLoginMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{4}
+}
+
 type OkResponseMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -229,7 +661,7 @@ type OkResponseMessage struct {
 
 func (x *OkResponseMessage) Reset() {
 	*x = OkResponseMessage{}
-	mi := &file_packets_proto_msgTypes[4]
+	mi := &file_packets_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -241,7 +673,7 @@ func (x *OkResponseMessage) String() string {
 func (*OkResponseMessage) ProtoMessage() {}
 
 func (x *OkResponseMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[4]
+	mi := &file_packets_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,7 +686,7 @@ func (x *OkResponseMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OkResponseMessage.ProtoReflect.Descriptor instead.
 func (*OkResponseMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{4}
+	return file_packets_proto_rawDescGZIP(), []int{5}
 }
 
 type DenyResponseMessage struct {
@@ -266,7 +698,7 @@ type DenyResponseMessage struct {
 
 func (x *DenyResponseMessage) Reset() {
 	*x = DenyResponseMessage{}
-	mi := &file_packets_proto_msgTypes[5]
+	mi := &file_packets_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +710,7 @@ func (x *DenyResponseMessage) String() string {
 func (*DenyResponseMessage) ProtoMessage() {}
 
 func (x *DenyResponseMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[5]
+	mi := &file_packets_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +723,7 @@ func (x *DenyResponseMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DenyResponseMessage.ProtoReflect.Descriptor instead.
 func (*DenyResponseMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{5}
+	return file_packets_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DenyResponseMessage) GetReason() string {
@@ -301,35 +733,31 @@ func (x *DenyResponseMessage) GetReason() string {
 	return ""
 }
 
-type PlayerMessage struct {
+type CellMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
-	Radius        float64                `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
-	Direction     float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
-	Speed         float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
-	Color         int32                  `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerMessage) Reset() {
-	*x = PlayerMessage{}
-	mi := &file_packets_proto_msgTypes[6]
+func (x *CellMessage) Reset() {
+	*x = CellMessage{}
+	mi := &file_packets_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerMessage) String() string {
+func (x *CellMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerMessage) ProtoMessage() {}
+func (*CellMessage) ProtoMessage() {}
 
-func (x *PlayerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[6]
+func (x *CellMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,55 +768,129 @@ func (x *PlayerMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerMessage.ProtoReflect.Descriptor instead.
-func (*PlayerMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CellMessage.ProtoReflect.Descriptor instead.
+func (*CellMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PlayerMessage) GetId() uint64 {
+func (x *CellMessage) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *PlayerMessage) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PlayerMessage) GetX() float64 {
+func (x *CellMessage) GetX() float64 {
 	if x != nil {
 		return x.X
 	}
 	return 0
 }
 
-func (x *PlayerMessage) GetY() float64 {
+func (x *CellMessage) GetY() float64 {
 	if x != nil {
 		return x.Y
 	}
 	return 0
 }
 
-func (x *PlayerMessage) GetRadius() float64 {
+func (x *CellMessage) GetRadius() float64 {
 	if x != nil {
 		return x.Radius
 	}
 	return 0
 }
 
-func (x *PlayerMessage) GetDirection() float64 {
-	if x != nil {
-		return x.Direction
-	}
-	return 0
-}
-
-func (x *PlayerMessage) GetSpeed() float64 {
-	if x != nil {
+type PlayerMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	Direction     float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	Speed         float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Color         int32                  `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	Cells         []*CellMessage         `protobuf:"bytes,9,rep,name=cells,proto3" json:"cells,omitempty"`
+	Team          uint32                 `protobuf:"varint,10,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerMessage) Reset() {
+	*x = PlayerMessage{}
+	mi := &file_packets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerMessage) ProtoMessage() {}
+
+func (x *PlayerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerMessage.ProtoReflect.Descriptor instead.
+func (*PlayerMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetDirection() float64 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetSpeed() float64 {
+	if x != nil {
 		return x.Speed
 	}
 	return 0
@@ -401,6 +903,20 @@ func (x *PlayerMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *PlayerMessage) GetCells() []*CellMessage {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *PlayerMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
 type PlayerDirectionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Direction     float64                `protobuf:"fixed64,1,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -410,7 +926,7 @@ type PlayerDirectionMessage struct {
 
 func (x *PlayerDirectionMessage) Reset() {
 	*x = PlayerDirectionMessage{}
-	mi := &file_packets_proto_msgTypes[7]
+	mi := &file_packets_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -422,7 +938,7 @@ func (x *PlayerDirectionMessage) String() string {
 func (*PlayerDirectionMessage) ProtoMessage() {}
 
 func (x *PlayerDirectionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[7]
+	mi := &file_packets_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +951,7 @@ func (x *PlayerDirectionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerDirectionMessage.ProtoReflect.Descriptor instead.
 func (*PlayerDirectionMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{7}
+	return file_packets_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PlayerDirectionMessage) GetDirection() float64 {
@@ -451,13 +967,15 @@ type SporeMessage struct {
 	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
 	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
 	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	VelX          float64                `protobuf:"fixed64,5,opt,name=vel_x,json=velX,proto3" json:"vel_x,omitempty"`
+	VelY          float64                `protobuf:"fixed64,6,opt,name=vel_y,json=velY,proto3" json:"vel_y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SporeMessage) Reset() {
 	*x = SporeMessage{}
-	mi := &file_packets_proto_msgTypes[8]
+	mi := &file_packets_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +987,7 @@ func (x *SporeMessage) String() string {
 func (*SporeMessage) ProtoMessage() {}
 
 func (x *SporeMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[8]
+	mi := &file_packets_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +1000,7 @@ func (x *SporeMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeMessage.ProtoReflect.Descriptor instead.
 func (*SporeMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{8}
+	return file_packets_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SporeMessage) GetId() uint64 {
@@ -513,16 +1031,32 @@ func (x *SporeMessage) GetRadius() float64 {
 	return 0
 }
 
+func (x *SporeMessage) GetVelX() float64 {
+	if x != nil {
+		return x.VelX
+	}
+	return 0
+}
+
+func (x *SporeMessage) GetVelY() float64 {
+	if x != nil {
+		return x.VelY
+	}
+	return 0
+}
+
 type SporeConsumedMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SporeId       uint64                 `protobuf:"varint,1,opt,name=spore_id,json=sporeId,proto3" json:"spore_id,omitempty"`
+	CellId        uint64                 `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	VirusId       uint64                 `protobuf:"varint,3,opt,name=virus_id,json=virusId,proto3" json:"virus_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SporeConsumedMessage) Reset() {
 	*x = SporeConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[9]
+	mi := &file_packets_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +1068,7 @@ func (x *SporeConsumedMessage) String() string {
 func (*SporeConsumedMessage) ProtoMessage() {}
 
 func (x *SporeConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[9]
+	mi := &file_packets_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +1081,7 @@ func (x *SporeConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeConsumedMessage.ProtoReflect.Descriptor instead.
 func (*SporeConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{9}
+	return file_packets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SporeConsumedMessage) GetSporeId() uint64 {
@@ -557,6 +1091,20 @@ func (x *SporeConsumedMessage) GetSporeId() uint64 {
 	return 0
 }
 
+func (x *SporeConsumedMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *SporeConsumedMessage) GetVirusId() uint64 {
+	if x != nil {
+		return x.VirusId
+	}
+	return 0
+}
+
 type SporesBatchMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Spores        []*SporeMessage        `protobuf:"bytes,1,rep,name=spores,proto3" json:"spores,omitempty"`
@@ -566,7 +1114,7 @@ type SporesBatchMessage struct {
 
 func (x *SporesBatchMessage) Reset() {
 	*x = SporesBatchMessage{}
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +1126,7 @@ func (x *SporesBatchMessage) String() string {
 func (*SporesBatchMessage) ProtoMessage() {}
 
 func (x *SporesBatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +1139,7 @@ func (x *SporesBatchMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporesBatchMessage.ProtoReflect.Descriptor instead.
 func (*SporesBatchMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{10}
+	return file_packets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SporesBatchMessage) GetSpores() []*SporeMessage {
@@ -604,13 +1152,15 @@ func (x *SporesBatchMessage) GetSpores() []*SporeMessage {
 type PlayerConsumedMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	CellId        uint64                 `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	ByCellId      uint64                 `protobuf:"varint,3,opt,name=by_cell_id,json=byCellId,proto3" json:"by_cell_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerConsumedMessage) Reset() {
 	*x = PlayerConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +1172,7 @@ func (x *PlayerConsumedMessage) String() string {
 func (*PlayerConsumedMessage) ProtoMessage() {}
 
 func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +1185,7 @@ func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerConsumedMessage.ProtoReflect.Descriptor instead.
 func (*PlayerConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{11}
+	return file_packets_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
@@ -645,6 +1195,20 @@ func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
 	return 0
 }
 
+func (x *PlayerConsumedMessage) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *PlayerConsumedMessage) GetByCellId() uint64 {
+	if x != nil {
+		return x.ByCellId
+	}
+	return 0
+}
+
 type HiscoreBoardRequestMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -653,7 +1217,7 @@ type HiscoreBoardRequestMessage struct {
 
 func (x *HiscoreBoardRequestMessage) Reset() {
 	*x = HiscoreBoardRequestMessage{}
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +1229,7 @@ func (x *HiscoreBoardRequestMessage) String() string {
 func (*HiscoreBoardRequestMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +1242,7 @@ func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardRequestMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardRequestMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{12}
+	return file_packets_proto_rawDescGZIP(), []int{14}
 }
 
 type HiscoreMessage struct {
@@ -692,7 +1256,7 @@ type HiscoreMessage struct {
 
 func (x *HiscoreMessage) Reset() {
 	*x = HiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +1268,7 @@ func (x *HiscoreMessage) String() string {
 func (*HiscoreMessage) ProtoMessage() {}
 
 func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +1281,7 @@ func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{13}
+	return file_packets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HiscoreMessage) GetRank() uint64 {
@@ -750,7 +1314,7 @@ type HiscoreBoardMessage struct {
 
 func (x *HiscoreBoardMessage) Reset() {
 	*x = HiscoreBoardMessage{}
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1326,7 @@ func (x *HiscoreBoardMessage) String() string {
 func (*HiscoreBoardMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1339,7 @@ func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{14}
+	return file_packets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HiscoreBoardMessage) GetHiscores() []*HiscoreMessage {
@@ -793,7 +1357,7 @@ type FinishedBrowsingHiscoresMessage struct {
 
 func (x *FinishedBrowsingHiscoresMessage) Reset() {
 	*x = FinishedBrowsingHiscoresMessage{}
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -805,7 +1369,7 @@ func (x *FinishedBrowsingHiscoresMessage) String() string {
 func (*FinishedBrowsingHiscoresMessage) ProtoMessage() {}
 
 func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1382,7 @@ func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishedBrowsingHiscoresMessage.ProtoReflect.Descriptor instead.
 func (*FinishedBrowsingHiscoresMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{15}
+	return file_packets_proto_rawDescGZIP(), []int{17}
 }
 
 type SearchHiscoreMessage struct {
@@ -830,7 +1394,7 @@ type SearchHiscoreMessage struct {
 
 func (x *SearchHiscoreMessage) Reset() {
 	*x = SearchHiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +1406,7 @@ func (x *SearchHiscoreMessage) String() string {
 func (*SearchHiscoreMessage) ProtoMessage() {}
 
 func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1419,7 @@ func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchHiscoreMessage.ProtoReflect.Descriptor instead.
 func (*SearchHiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{16}
+	return file_packets_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SearchHiscoreMessage) GetName() string {
@@ -874,7 +1438,7 @@ type DisconnectMessage struct {
 
 func (x *DisconnectMessage) Reset() {
 	*x = DisconnectMessage{}
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1450,7 @@ func (x *DisconnectMessage) String() string {
 func (*DisconnectMessage) ProtoMessage() {}
 
 func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1463,7 @@ func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectMessage.ProtoReflect.Descriptor instead.
 func (*DisconnectMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{17}
+	return file_packets_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DisconnectMessage) GetReason() string {
@@ -909,49 +1473,33 @@ func (x *DisconnectMessage) GetReason() string {
 	return ""
 }
 
-type Packet struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//
-	//	*Packet_Chat
-	//	*Packet_Id
-	//	*Packet_LoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_OkResponse
-	//	*Packet_DenyResponse
-	//	*Packet_Player
-	//	*Packet_PlayerDirection
-	//	*Packet_Spore
-	//	*Packet_SporeConsumed
-	//	*Packet_SporesBatch
-	//	*Packet_PlayerConsumed
-	//	*Packet_HiscoreBoardRequest
-	//	*Packet_Hiscore
-	//	*Packet_HiscoreBoard
-	//	*Packet_FinishedBrowsingHiscores
-	//	*Packet_SearchHiscore
-	//	*Packet_Disconnect
-	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+type DeathMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KillerId      uint64                 `protobuf:"varint,1,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
+	KillerName    string                 `protobuf:"bytes,2,opt,name=killer_name,json=killerName,proto3" json:"killer_name,omitempty"`
+	SurvivalTime  float64                `protobuf:"fixed64,3,opt,name=survival_time,json=survivalTime,proto3" json:"survival_time,omitempty"`
+	PeakMass      float64                `protobuf:"fixed64,4,opt,name=peak_mass,json=peakMass,proto3" json:"peak_mass,omitempty"`
+	Rank          uint64                 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	RespawnDelay  float64                `protobuf:"fixed64,6,opt,name=respawn_delay,json=respawnDelay,proto3" json:"respawn_delay,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
-	mi := &file_packets_proto_msgTypes[18]
+func (x *DeathMessage) Reset() {
+	*x = DeathMessage{}
+	mi := &file_packets_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *DeathMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*DeathMessage) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[18]
+func (x *DeathMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,182 +1510,2272 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeathMessage.ProtoReflect.Descriptor instead.
+func (*DeathMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Packet) GetSenderId() uint64 {
+func (x *DeathMessage) GetKillerId() uint64 {
 	if x != nil {
-		return x.SenderId
+		return x.KillerId
 	}
 	return 0
 }
 
-func (x *Packet) GetMsg() isPacket_Msg {
+func (x *DeathMessage) GetKillerName() string {
 	if x != nil {
-		return x.Msg
+		return x.KillerName
 	}
-	return nil
+	return ""
 }
 
-func (x *Packet) GetChat() *ChatMessage {
+func (x *DeathMessage) GetSurvivalTime() float64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Chat); ok {
-			return x.Chat
-		}
+		return x.SurvivalTime
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetId() *IdMessage {
+func (x *DeathMessage) GetPeakMass() float64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Id); ok {
-			return x.Id
-		}
+		return x.PeakMass
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+func (x *DeathMessage) GetRank() uint64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
-			return x.LoginRequest
-		}
+		return x.Rank
 	}
-	return nil
+	return 0
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+func (x *DeathMessage) GetRespawnDelay() float64 {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
-			return x.RegisterRequest
-		}
+		return x.RespawnDelay
 	}
-	return nil
+	return 0
+}
+
+type RespawnRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespawnRequestMessage) Reset() {
+	*x = RespawnRequestMessage{}
+	mi := &file_packets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnRequestMessage) ProtoMessage() {}
+
+func (x *RespawnRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnRequestMessage.ProtoReflect.Descriptor instead.
+func (*RespawnRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{21}
+}
+
+type SplitRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitRequestMessage) Reset() {
+	*x = SplitRequestMessage{}
+	mi := &file_packets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitRequestMessage) ProtoMessage() {}
+
+func (x *SplitRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitRequestMessage.ProtoReflect.Descriptor instead.
+func (*SplitRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{22}
+}
+
+type EjectMassMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EjectMassMessage) Reset() {
+	*x = EjectMassMessage{}
+	mi := &file_packets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EjectMassMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EjectMassMessage) ProtoMessage() {}
+
+func (x *EjectMassMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EjectMassMessage.ProtoReflect.Descriptor instead.
+func (*EjectMassMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{23}
+}
+
+type VirusMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	VelX          float64                `protobuf:"fixed64,5,opt,name=vel_x,json=velX,proto3" json:"vel_x,omitempty"`
+	VelY          float64                `protobuf:"fixed64,6,opt,name=vel_y,json=velY,proto3" json:"vel_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirusMessage) Reset() {
+	*x = VirusMessage{}
+	mi := &file_packets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirusMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirusMessage) ProtoMessage() {}
+
+func (x *VirusMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirusMessage.ProtoReflect.Descriptor instead.
+func (*VirusMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VirusMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetVelX() float64 {
+	if x != nil {
+		return x.VelX
+	}
+	return 0
+}
+
+func (x *VirusMessage) GetVelY() float64 {
+	if x != nil {
+		return x.VelY
+	}
+	return 0
+}
+
+type VirusConsumedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VirusId       uint64                 `protobuf:"varint,1,opt,name=virus_id,json=virusId,proto3" json:"virus_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirusConsumedMessage) Reset() {
+	*x = VirusConsumedMessage{}
+	mi := &file_packets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirusConsumedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirusConsumedMessage) ProtoMessage() {}
+
+func (x *VirusConsumedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirusConsumedMessage.ProtoReflect.Descriptor instead.
+func (*VirusConsumedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VirusConsumedMessage) GetVirusId() uint64 {
+	if x != nil {
+		return x.VirusId
+	}
+	return 0
+}
+
+type PowerUpMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind          PowerUpKind            `protobuf:"varint,5,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpMessage) Reset() {
+	*x = PowerUpMessage{}
+	mi := &file_packets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpMessage) ProtoMessage() {}
+
+func (x *PowerUpMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PowerUpMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_KIND_SPEED_BOOST
+}
+
+type PlayerEffectMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          PowerUpKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	Duration      float64                `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	PowerUpId     uint64                 `protobuf:"varint,3,opt,name=power_up_id,json=powerUpId,proto3" json:"power_up_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerEffectMessage) Reset() {
+	*x = PlayerEffectMessage{}
+	mi := &file_packets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerEffectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerEffectMessage) ProtoMessage() {}
+
+func (x *PlayerEffectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerEffectMessage.ProtoReflect.Descriptor instead.
+func (*PlayerEffectMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PlayerEffectMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_KIND_SPEED_BOOST
+}
+
+func (x *PlayerEffectMessage) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PlayerEffectMessage) GetPowerUpId() uint64 {
+	if x != nil {
+		return x.PowerUpId
+	}
+	return 0
+}
+
+type TeamScoreMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          uint32                 `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         int32                  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	Mass          float64                `protobuf:"fixed64,4,opt,name=mass,proto3" json:"mass,omitempty"`
+	Players       uint32                 `protobuf:"varint,5,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoreMessage) Reset() {
+	*x = TeamScoreMessage{}
+	mi := &file_packets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreMessage) ProtoMessage() {}
+
+func (x *TeamScoreMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoreMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TeamScoreMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamScoreMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+type TeamScoreboardMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamScoreMessage    `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoreboardMessage) Reset() {
+	*x = TeamScoreboardMessage{}
+	mi := &file_packets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreboardMessage) ProtoMessage() {}
+
+func (x *TeamScoreboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoreboardMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TeamScoreboardMessage) GetTeams() []*TeamScoreMessage {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type RoundPhaseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         RoundPhase             `protobuf:"varint,1,opt,name=phase,proto3,enum=packets.RoundPhase" json:"phase,omitempty"`
+	TimeRemaining float64                `protobuf:"fixed64,2,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	MinPlayers    uint32                 `protobuf:"varint,3,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	ZoneRadius    float64                `protobuf:"fixed64,4,opt,name=zone_radius,json=zoneRadius,proto3" json:"zone_radius,omitempty"`
+	ZoneEndRadius float64                `protobuf:"fixed64,5,opt,name=zone_end_radius,json=zoneEndRadius,proto3" json:"zone_end_radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundPhaseMessage) Reset() {
+	*x = RoundPhaseMessage{}
+	mi := &file_packets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundPhaseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundPhaseMessage) ProtoMessage() {}
+
+func (x *RoundPhaseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundPhaseMessage.ProtoReflect.Descriptor instead.
+func (*RoundPhaseMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RoundPhaseMessage) GetPhase() RoundPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return RoundPhase_ROUND_PHASE_LOBBY
+}
+
+func (x *RoundPhaseMessage) GetTimeRemaining() float64 {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return 0
+}
+
+func (x *RoundPhaseMessage) GetMinPlayers() uint32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *RoundPhaseMessage) GetZoneRadius() float64 {
+	if x != nil {
+		return x.ZoneRadius
+	}
+	return 0
+}
+
+func (x *RoundPhaseMessage) GetZoneEndRadius() float64 {
+	if x != nil {
+		return x.ZoneEndRadius
+	}
+	return 0
+}
+
+type RoundPlacementMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     uint32                 `protobuf:"varint,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	PlayerId      uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundPlacementMessage) Reset() {
+	*x = RoundPlacementMessage{}
+	mi := &file_packets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundPlacementMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundPlacementMessage) ProtoMessage() {}
+
+func (x *RoundPlacementMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundPlacementMessage.ProtoReflect.Descriptor instead.
+func (*RoundPlacementMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RoundPlacementMessage) GetPlacement() uint32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+func (x *RoundPlacementMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RoundPlacementMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RoundResultMessage struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	WinnerId      uint64                   `protobuf:"varint,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	WinnerName    string                   `protobuf:"bytes,2,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	Placements    []*RoundPlacementMessage `protobuf:"bytes,3,rep,name=placements,proto3" json:"placements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundResultMessage) Reset() {
+	*x = RoundResultMessage{}
+	mi := &file_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundResultMessage) ProtoMessage() {}
+
+func (x *RoundResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundResultMessage.ProtoReflect.Descriptor instead.
+func (*RoundResultMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RoundResultMessage) GetWinnerId() uint64 {
+	if x != nil {
+		return x.WinnerId
+	}
+	return 0
+}
+
+func (x *RoundResultMessage) GetWinnerName() string {
+	if x != nil {
+		return x.WinnerName
+	}
+	return ""
+}
+
+func (x *RoundResultMessage) GetPlacements() []*RoundPlacementMessage {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+type ReportPlayerMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,2,opt,name=reason,proto3,enum=packets.ReportReason" json:"reason,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPlayerMessage) Reset() {
+	*x = ReportPlayerMessage{}
+	mi := &file_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPlayerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPlayerMessage) ProtoMessage() {}
+
+func (x *ReportPlayerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPlayerMessage.ProtoReflect.Descriptor instead.
+func (*ReportPlayerMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReportPlayerMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReportPlayerMessage) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_OTHER
+}
+
+func (x *ReportPlayerMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type FriendRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequestMessage) Reset() {
+	*x = FriendRequestMessage{}
+	mi := &file_packets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestMessage) ProtoMessage() {}
+
+func (x *FriendRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestMessage.ProtoReflect.Descriptor instead.
+func (*FriendRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FriendRequestMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FriendAcceptMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendAcceptMessage) Reset() {
+	*x = FriendAcceptMessage{}
+	mi := &file_packets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendAcceptMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendAcceptMessage) ProtoMessage() {}
+
+func (x *FriendAcceptMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendAcceptMessage.ProtoReflect.Descriptor instead.
+func (*FriendAcceptMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FriendAcceptMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FriendRemoveMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRemoveMessage) Reset() {
+	*x = FriendRemoveMessage{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRemoveMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRemoveMessage) ProtoMessage() {}
+
+func (x *FriendRemoveMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRemoveMessage.ProtoReflect.Descriptor instead.
+func (*FriendRemoveMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FriendRemoveMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FriendListRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendListRequestMessage) Reset() {
+	*x = FriendListRequestMessage{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendListRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendListRequestMessage) ProtoMessage() {}
+
+func (x *FriendListRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendListRequestMessage.ProtoReflect.Descriptor instead.
+func (*FriendListRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
+}
+
+type FriendMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        FriendStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=packets.FriendStatus" json:"status,omitempty"`
+	Presence      Presence               `protobuf:"varint,3,opt,name=presence,proto3,enum=packets.Presence" json:"presence,omitempty"`
+	Mass          float64                `protobuf:"fixed64,4,opt,name=mass,proto3" json:"mass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendMessage) Reset() {
+	*x = FriendMessage{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendMessage) ProtoMessage() {}
+
+func (x *FriendMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendMessage.ProtoReflect.Descriptor instead.
+func (*FriendMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FriendMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendMessage) GetStatus() FriendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FriendStatus_FRIEND_STATUS_ACCEPTED
+}
+
+func (x *FriendMessage) GetPresence() Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return Presence_PRESENCE_OFFLINE
+}
+
+func (x *FriendMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+type FriendListMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*FriendMessage       `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendListMessage) Reset() {
+	*x = FriendListMessage{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendListMessage) ProtoMessage() {}
+
+func (x *FriendListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendListMessage.ProtoReflect.Descriptor instead.
+func (*FriendListMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FriendListMessage) GetFriends() []*FriendMessage {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+type FriendOnlineMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendOnlineMessage) Reset() {
+	*x = FriendOnlineMessage{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendOnlineMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendOnlineMessage) ProtoMessage() {}
+
+func (x *FriendOnlineMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendOnlineMessage.ProtoReflect.Descriptor instead.
+func (*FriendOnlineMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FriendOnlineMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyInviteMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyInviteMessage) Reset() {
+	*x = PartyInviteMessage{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyInviteMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyInviteMessage) ProtoMessage() {}
+
+func (x *PartyInviteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyInviteMessage.ProtoReflect.Descriptor instead.
+func (*PartyInviteMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PartyInviteMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyJoinMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyJoinMessage) Reset() {
+	*x = PartyJoinMessage{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyJoinMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyJoinMessage) ProtoMessage() {}
+
+func (x *PartyJoinMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyJoinMessage.ProtoReflect.Descriptor instead.
+func (*PartyJoinMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PartyJoinMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyLeaveMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyLeaveMessage) Reset() {
+	*x = PartyLeaveMessage{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyLeaveMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyLeaveMessage) ProtoMessage() {}
+
+func (x *PartyLeaveMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyLeaveMessage.ProtoReflect.Descriptor instead.
+func (*PartyLeaveMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+type PartyKickMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyKickMessage) Reset() {
+	*x = PartyKickMessage{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyKickMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyKickMessage) ProtoMessage() {}
+
+func (x *PartyKickMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyKickMessage.ProtoReflect.Descriptor instead.
+func (*PartyKickMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PartyKickMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PartyMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leader        string                 `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyMessage) Reset() {
+	*x = PartyMessage{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyMessage) ProtoMessage() {}
+
+func (x *PartyMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyMessage.ProtoReflect.Descriptor instead.
+func (*PartyMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PartyMessage) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *PartyMessage) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ChangePasswordMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordMessage) Reset() {
+	*x = ChangePasswordMessage{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordMessage) ProtoMessage() {}
+
+func (x *ChangePasswordMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordMessage.ProtoReflect.Descriptor instead.
+func (*ChangePasswordMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChangePasswordMessage) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordMessage) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeNameMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeNameMessage) Reset() {
+	*x = ChangeNameMessage{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeNameMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeNameMessage) ProtoMessage() {}
+
+func (x *ChangeNameMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeNameMessage.ProtoReflect.Descriptor instead.
+func (*ChangeNameMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChangeNameMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ChangeColorMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Color         int32                  `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeColorMessage) Reset() {
+	*x = ChangeColorMessage{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeColorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeColorMessage) ProtoMessage() {}
+
+func (x *ChangeColorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeColorMessage.ProtoReflect.Descriptor instead.
+func (*ChangeColorMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChangeColorMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+type DeleteAccountMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountMessage) Reset() {
+	*x = DeleteAccountMessage{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountMessage) ProtoMessage() {}
+
+func (x *DeleteAccountMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountMessage.ProtoReflect.Descriptor instead.
+func (*DeleteAccountMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAccountMessage) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LiveLeaderboardEntryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerId      uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Mass          float64                `protobuf:"fixed64,4,opt,name=mass,proto3" json:"mass,omitempty"`
+	Color         int32                  `protobuf:"varint,5,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveLeaderboardEntryMessage) Reset() {
+	*x = LiveLeaderboardEntryMessage{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveLeaderboardEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveLeaderboardEntryMessage) ProtoMessage() {}
+
+func (x *LiveLeaderboardEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveLeaderboardEntryMessage.ProtoReflect.Descriptor instead.
+func (*LiveLeaderboardEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LiveLeaderboardEntryMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LiveLeaderboardEntryMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *LiveLeaderboardEntryMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LiveLeaderboardEntryMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *LiveLeaderboardEntryMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+type LiveLeaderboardMessage struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Entries       []*LiveLeaderboardEntryMessage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	OwnRank       uint32                         `protobuf:"varint,2,opt,name=own_rank,json=ownRank,proto3" json:"own_rank,omitempty"`
+	OwnMass       float64                        `protobuf:"fixed64,3,opt,name=own_mass,json=ownMass,proto3" json:"own_mass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveLeaderboardMessage) Reset() {
+	*x = LiveLeaderboardMessage{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveLeaderboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveLeaderboardMessage) ProtoMessage() {}
+
+func (x *LiveLeaderboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveLeaderboardMessage.ProtoReflect.Descriptor instead.
+func (*LiveLeaderboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LiveLeaderboardMessage) GetEntries() []*LiveLeaderboardEntryMessage {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LiveLeaderboardMessage) GetOwnRank() uint32 {
+	if x != nil {
+		return x.OwnRank
+	}
+	return 0
+}
+
+func (x *LiveLeaderboardMessage) GetOwnMass() float64 {
+	if x != nil {
+		return x.OwnMass
+	}
+	return 0
+}
+
+type WorldInfoMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shape         ArenaShape             `protobuf:"varint,1,opt,name=shape,proto3,enum=packets.ArenaShape" json:"shape,omitempty"`
+	Width         float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldInfoMessage) Reset() {
+	*x = WorldInfoMessage{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldInfoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldInfoMessage) ProtoMessage() {}
+
+func (x *WorldInfoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldInfoMessage.ProtoReflect.Descriptor instead.
+func (*WorldInfoMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WorldInfoMessage) GetShape() ArenaShape {
+	if x != nil {
+		return x.Shape
+	}
+	return ArenaShape_ARENA_SHAPE_RECTANGLE
+}
+
+func (x *WorldInfoMessage) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *WorldInfoMessage) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *WorldInfoMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type Packet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//
+	//	*Packet_Chat
+	//	*Packet_Id
+	//	*Packet_LoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_OkResponse
+	//	*Packet_DenyResponse
+	//	*Packet_Player
+	//	*Packet_PlayerDirection
+	//	*Packet_Spore
+	//	*Packet_SporeConsumed
+	//	*Packet_SporesBatch
+	//	*Packet_PlayerConsumed
+	//	*Packet_HiscoreBoardRequest
+	//	*Packet_Hiscore
+	//	*Packet_HiscoreBoard
+	//	*Packet_FinishedBrowsingHiscores
+	//	*Packet_SearchHiscore
+	//	*Packet_Disconnect
+	//	*Packet_Death
+	//	*Packet_RespawnRequest
+	//	*Packet_SplitRequest
+	//	*Packet_EjectMass
+	//	*Packet_WorldInfo
+	//	*Packet_Virus
+	//	*Packet_VirusConsumed
+	//	*Packet_PowerUp
+	//	*Packet_PlayerEffect
+	//	*Packet_TeamScoreboard
+	//	*Packet_RoundPhase
+	//	*Packet_RoundResult
+	//	*Packet_LiveLeaderboard
+	//	*Packet_ReportPlayer
+	//	*Packet_FriendRequest
+	//	*Packet_FriendAccept
+	//	*Packet_FriendRemove
+	//	*Packet_FriendListRequest
+	//	*Packet_FriendList
+	//	*Packet_Friend
+	//	*Packet_FriendOnline
+	//	*Packet_PartyInvite
+	//	*Packet_PartyJoin
+	//	*Packet_PartyLeave
+	//	*Packet_PartyKick
+	//	*Packet_Party
+	//	*Packet_ChangePassword
+	//	*Packet_ChangeName
+	//	*Packet_ChangeColor
+	//	*Packet_DeleteAccount
+	//	*Packet_GuestLogin
+	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Packet) GetMsg() isPacket_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetId() *IdMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Id); ok {
+			return x.Id
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
+			return x.LoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
+			return x.RegisterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOkResponse() *OkResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_OkResponse); ok {
+			return x.OkResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
+			return x.DenyResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayer() *PlayerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
+			return x.PlayerDirection
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpore() *SporeMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Spore); ok {
+			return x.Spore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
+			return x.SporeConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporesBatch() *SporesBatchMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
+			return x.SporesBatch
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
+			return x.PlayerConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoardRequest() *HiscoreBoardRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoardRequest); ok {
+			return x.HiscoreBoardRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscore() *HiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Hiscore); ok {
+			return x.Hiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
+			return x.HiscoreBoard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFinishedBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FinishedBrowsingHiscores); ok {
+			return x.FinishedBrowsingHiscores
+		}
+	}
+	return nil
 }
 
-func (x *Packet) GetOkResponse() *OkResponseMessage {
+func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_OkResponse); ok {
-			return x.OkResponse
+		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
+			return x.SearchHiscore
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+func (x *Packet) GetDisconnect() *DisconnectMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
-			return x.DenyResponse
+		if x, ok := x.Msg.(*Packet_Disconnect); ok {
+			return x.Disconnect
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayer() *PlayerMessage {
+func (x *Packet) GetDeath() *DeathMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Player); ok {
-			return x.Player
+		if x, ok := x.Msg.(*Packet_Death); ok {
+			return x.Death
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+func (x *Packet) GetRespawnRequest() *RespawnRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
-			return x.PlayerDirection
+		if x, ok := x.Msg.(*Packet_RespawnRequest); ok {
+			return x.RespawnRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSpore() *SporeMessage {
+func (x *Packet) GetSplitRequest() *SplitRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Spore); ok {
-			return x.Spore
+		if x, ok := x.Msg.(*Packet_SplitRequest); ok {
+			return x.SplitRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+func (x *Packet) GetEjectMass() *EjectMassMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
-			return x.SporeConsumed
+		if x, ok := x.Msg.(*Packet_EjectMass); ok {
+			return x.EjectMass
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporesBatch() *SporesBatchMessage {
+func (x *Packet) GetWorldInfo() *WorldInfoMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
-			return x.SporesBatch
+		if x, ok := x.Msg.(*Packet_WorldInfo); ok {
+			return x.WorldInfo
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+func (x *Packet) GetVirus() *VirusMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
-			return x.PlayerConsumed
+		if x, ok := x.Msg.(*Packet_Virus); ok {
+			return x.Virus
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoardRequest() *HiscoreBoardRequestMessage {
+func (x *Packet) GetVirusConsumed() *VirusConsumedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoardRequest); ok {
-			return x.HiscoreBoardRequest
+		if x, ok := x.Msg.(*Packet_VirusConsumed); ok {
+			return x.VirusConsumed
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscore() *HiscoreMessage {
+func (x *Packet) GetPowerUp() *PowerUpMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Hiscore); ok {
-			return x.Hiscore
+		if x, ok := x.Msg.(*Packet_PowerUp); ok {
+			return x.PowerUp
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+func (x *Packet) GetPlayerEffect() *PlayerEffectMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
-			return x.HiscoreBoard
+		if x, ok := x.Msg.(*Packet_PlayerEffect); ok {
+			return x.PlayerEffect
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetFinishedBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+func (x *Packet) GetTeamScoreboard() *TeamScoreboardMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_FinishedBrowsingHiscores); ok {
-			return x.FinishedBrowsingHiscores
+		if x, ok := x.Msg.(*Packet_TeamScoreboard); ok {
+			return x.TeamScoreboard
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+func (x *Packet) GetRoundPhase() *RoundPhaseMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
-			return x.SearchHiscore
+		if x, ok := x.Msg.(*Packet_RoundPhase); ok {
+			return x.RoundPhase
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDisconnect() *DisconnectMessage {
+func (x *Packet) GetRoundResult() *RoundResultMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Disconnect); ok {
-			return x.Disconnect
+		if x, ok := x.Msg.(*Packet_RoundResult); ok {
+			return x.RoundResult
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLiveLeaderboard() *LiveLeaderboardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LiveLeaderboard); ok {
+			return x.LiveLeaderboard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetReportPlayer() *ReportPlayerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ReportPlayer); ok {
+			return x.ReportPlayer
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendRequest() *FriendRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendRequest); ok {
+			return x.FriendRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendAccept() *FriendAcceptMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendAccept); ok {
+			return x.FriendAccept
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendRemove() *FriendRemoveMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendRemove); ok {
+			return x.FriendRemove
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendListRequest() *FriendListRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendListRequest); ok {
+			return x.FriendListRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendList() *FriendListMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendList); ok {
+			return x.FriendList
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriend() *FriendMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Friend); ok {
+			return x.Friend
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFriendOnline() *FriendOnlineMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FriendOnline); ok {
+			return x.FriendOnline
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyInvite() *PartyInviteMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyInvite); ok {
+			return x.PartyInvite
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyJoin() *PartyJoinMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyJoin); ok {
+			return x.PartyJoin
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyLeave() *PartyLeaveMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyLeave); ok {
+			return x.PartyLeave
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPartyKick() *PartyKickMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PartyKick); ok {
+			return x.PartyKick
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetParty() *PartyMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Party); ok {
+			return x.Party
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangePassword() *ChangePasswordMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangePassword); ok {
+			return x.ChangePassword
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangeName() *ChangeNameMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangeName); ok {
+			return x.ChangeName
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangeColor() *ChangeColorMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangeColor); ok {
+			return x.ChangeColor
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDeleteAccount() *DeleteAccountMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DeleteAccount); ok {
+			return x.DeleteAccount
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGuestLogin() *GuestLoginMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GuestLogin); ok {
+			return x.GuestLogin
 		}
 	}
 	return nil
@@ -1219,6 +3857,130 @@ type Packet_Disconnect struct {
 	Disconnect *DisconnectMessage `protobuf:"bytes,19,opt,name=disconnect,proto3,oneof"`
 }
 
+type Packet_Death struct {
+	Death *DeathMessage `protobuf:"bytes,20,opt,name=death,proto3,oneof"`
+}
+
+type Packet_RespawnRequest struct {
+	RespawnRequest *RespawnRequestMessage `protobuf:"bytes,21,opt,name=respawn_request,json=respawnRequest,proto3,oneof"`
+}
+
+type Packet_SplitRequest struct {
+	SplitRequest *SplitRequestMessage `protobuf:"bytes,22,opt,name=split_request,json=splitRequest,proto3,oneof"`
+}
+
+type Packet_EjectMass struct {
+	EjectMass *EjectMassMessage `protobuf:"bytes,23,opt,name=eject_mass,json=ejectMass,proto3,oneof"`
+}
+
+type Packet_WorldInfo struct {
+	WorldInfo *WorldInfoMessage `protobuf:"bytes,24,opt,name=world_info,json=worldInfo,proto3,oneof"`
+}
+
+type Packet_Virus struct {
+	Virus *VirusMessage `protobuf:"bytes,25,opt,name=virus,proto3,oneof"`
+}
+
+type Packet_VirusConsumed struct {
+	VirusConsumed *VirusConsumedMessage `protobuf:"bytes,26,opt,name=virus_consumed,json=virusConsumed,proto3,oneof"`
+}
+
+type Packet_PowerUp struct {
+	PowerUp *PowerUpMessage `protobuf:"bytes,27,opt,name=power_up,json=powerUp,proto3,oneof"`
+}
+
+type Packet_PlayerEffect struct {
+	PlayerEffect *PlayerEffectMessage `protobuf:"bytes,28,opt,name=player_effect,json=playerEffect,proto3,oneof"`
+}
+
+type Packet_TeamScoreboard struct {
+	TeamScoreboard *TeamScoreboardMessage `protobuf:"bytes,29,opt,name=team_scoreboard,json=teamScoreboard,proto3,oneof"`
+}
+
+type Packet_RoundPhase struct {
+	RoundPhase *RoundPhaseMessage `protobuf:"bytes,30,opt,name=round_phase,json=roundPhase,proto3,oneof"`
+}
+
+type Packet_RoundResult struct {
+	RoundResult *RoundResultMessage `protobuf:"bytes,31,opt,name=round_result,json=roundResult,proto3,oneof"`
+}
+
+type Packet_LiveLeaderboard struct {
+	LiveLeaderboard *LiveLeaderboardMessage `protobuf:"bytes,32,opt,name=live_leaderboard,json=liveLeaderboard,proto3,oneof"`
+}
+
+type Packet_ReportPlayer struct {
+	ReportPlayer *ReportPlayerMessage `protobuf:"bytes,33,opt,name=report_player,json=reportPlayer,proto3,oneof"`
+}
+
+type Packet_FriendRequest struct {
+	FriendRequest *FriendRequestMessage `protobuf:"bytes,34,opt,name=friend_request,json=friendRequest,proto3,oneof"`
+}
+
+type Packet_FriendAccept struct {
+	FriendAccept *FriendAcceptMessage `protobuf:"bytes,35,opt,name=friend_accept,json=friendAccept,proto3,oneof"`
+}
+
+type Packet_FriendRemove struct {
+	FriendRemove *FriendRemoveMessage `protobuf:"bytes,36,opt,name=friend_remove,json=friendRemove,proto3,oneof"`
+}
+
+type Packet_FriendListRequest struct {
+	FriendListRequest *FriendListRequestMessage `protobuf:"bytes,37,opt,name=friend_list_request,json=friendListRequest,proto3,oneof"`
+}
+
+type Packet_FriendList struct {
+	FriendList *FriendListMessage `protobuf:"bytes,38,opt,name=friend_list,json=friendList,proto3,oneof"`
+}
+
+type Packet_Friend struct {
+	Friend *FriendMessage `protobuf:"bytes,39,opt,name=friend,proto3,oneof"`
+}
+
+type Packet_FriendOnline struct {
+	FriendOnline *FriendOnlineMessage `protobuf:"bytes,40,opt,name=friend_online,json=friendOnline,proto3,oneof"`
+}
+
+type Packet_PartyInvite struct {
+	PartyInvite *PartyInviteMessage `protobuf:"bytes,41,opt,name=party_invite,json=partyInvite,proto3,oneof"`
+}
+
+type Packet_PartyJoin struct {
+	PartyJoin *PartyJoinMessage `protobuf:"bytes,42,opt,name=party_join,json=partyJoin,proto3,oneof"`
+}
+
+type Packet_PartyLeave struct {
+	PartyLeave *PartyLeaveMessage `protobuf:"bytes,43,opt,name=party_leave,json=partyLeave,proto3,oneof"`
+}
+
+type Packet_PartyKick struct {
+	PartyKick *PartyKickMessage `protobuf:"bytes,44,opt,name=party_kick,json=partyKick,proto3,oneof"`
+}
+
+type Packet_Party struct {
+	Party *PartyMessage `protobuf:"bytes,45,opt,name=party,proto3,oneof"`
+}
+
+type Packet_ChangePassword struct {
+	ChangePassword *ChangePasswordMessage `protobuf:"bytes,46,opt,name=change_password,json=changePassword,proto3,oneof"`
+}
+
+type Packet_ChangeName struct {
+	ChangeName *ChangeNameMessage `protobuf:"bytes,47,opt,name=change_name,json=changeName,proto3,oneof"`
+}
+
+type Packet_ChangeColor struct {
+	ChangeColor *ChangeColorMessage `protobuf:"bytes,48,opt,name=change_color,json=changeColor,proto3,oneof"`
+}
+
+type Packet_DeleteAccount struct {
+	DeleteAccount *DeleteAccountMessage `protobuf:"bytes,49,opt,name=delete_account,json=deleteAccount,proto3,oneof"`
+}
+
+type Packet_GuestLogin struct {
+	GuestLogin *GuestLoginMessage `protobuf:"bytes,50,opt,name=guest_login,json=guestLogin,proto3,oneof"`
+}
+
 func (*Packet_Chat) isPacket_Msg() {}
 
 func (*Packet_Id) isPacket_Msg() {}
@@ -1255,160 +4017,601 @@ func (*Packet_SearchHiscore) isPacket_Msg() {}
 
 func (*Packet_Disconnect) isPacket_Msg() {}
 
+func (*Packet_Death) isPacket_Msg() {}
+
+func (*Packet_RespawnRequest) isPacket_Msg() {}
+
+func (*Packet_SplitRequest) isPacket_Msg() {}
+
+func (*Packet_EjectMass) isPacket_Msg() {}
+
+func (*Packet_WorldInfo) isPacket_Msg() {}
+
+func (*Packet_Virus) isPacket_Msg() {}
+
+func (*Packet_VirusConsumed) isPacket_Msg() {}
+
+func (*Packet_PowerUp) isPacket_Msg() {}
+
+func (*Packet_PlayerEffect) isPacket_Msg() {}
+
+func (*Packet_TeamScoreboard) isPacket_Msg() {}
+
+func (*Packet_RoundPhase) isPacket_Msg() {}
+
+func (*Packet_RoundResult) isPacket_Msg() {}
+
+func (*Packet_LiveLeaderboard) isPacket_Msg() {}
+
+func (*Packet_ReportPlayer) isPacket_Msg() {}
+
+func (*Packet_FriendRequest) isPacket_Msg() {}
+
+func (*Packet_FriendAccept) isPacket_Msg() {}
+
+func (*Packet_FriendRemove) isPacket_Msg() {}
+
+func (*Packet_FriendListRequest) isPacket_Msg() {}
+
+func (*Packet_FriendList) isPacket_Msg() {}
+
+func (*Packet_Friend) isPacket_Msg() {}
+
+func (*Packet_FriendOnline) isPacket_Msg() {}
+
+func (*Packet_PartyInvite) isPacket_Msg() {}
+
+func (*Packet_PartyJoin) isPacket_Msg() {}
+
+func (*Packet_PartyLeave) isPacket_Msg() {}
+
+func (*Packet_PartyKick) isPacket_Msg() {}
+
+func (*Packet_Party) isPacket_Msg() {}
+
+func (*Packet_ChangePassword) isPacket_Msg() {}
+
+func (*Packet_ChangeName) isPacket_Msg() {}
+
+func (*Packet_ChangeColor) isPacket_Msg() {}
+
+func (*Packet_DeleteAccount) isPacket_Msg() {}
+
+func (*Packet_GuestLogin) isPacket_Msg() {}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 var file_packets_proto_rawDesc = string([]byte{
 	0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x1b, 0x0a, 0x09, 0x49, 0x64, 0x4d,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22,
+	0x1b, 0x0a, 0x09, 0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x13,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x66, 0x0a, 0x16, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4f, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a,
+	0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0b,
+	0x43, 0x65, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22,
+	0xf1, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x65, 0x6c,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x22, 0x36, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7c, 0x0a, 0x0c, 0x53,
+	0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12,
+	0x13, 0x0a, 0x05, 0x76, 0x65, 0x6c, 0x5f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x76, 0x65, 0x6c, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x65, 0x6c, 0x5f, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x6c, 0x59, 0x22, 0x65, 0x0a, 0x14, 0x53, 0x70, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x72, 0x75, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x69, 0x72, 0x75, 0x73, 0x49, 0x64,
+	0x22, 0x43, 0x0a, 0x12, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73,
+	0x70, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65,
+	0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x79, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79, 0x43, 0x65, 0x6c, 0x6c,
+	0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x4e, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x4a, 0x0a, 0x13, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x68, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x21, 0x0a, 0x1f,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67,
+	0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x2a, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x61,
+	0x74, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x69, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6b, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x69, 0x6c,
+	0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x72, 0x76, 0x69,
+	0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x65, 0x61, 0x6b, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7c, 0x0a, 0x0c, 0x56, 0x69, 0x72, 0x75, 0x73, 0x4d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x13, 0x0a,
-	0x11, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a,
-	0x0c, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64,
-	0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
-	0x73, 0x22, 0x31, 0x0a, 0x14, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x6f,
-	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x6f,
-	0x72, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x12, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x70,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x1c, 0x0a, 0x1a, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e, 0x0a,
-	0x0e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72,
-	0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x4a, 0x0a,
-	0x13, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x08, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x14,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x65,
+	0x6c, 0x5f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x6c, 0x58, 0x12,
+	0x13, 0x0a, 0x05, 0x76, 0x65, 0x6c, 0x5f, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x76, 0x65, 0x6c, 0x59, 0x22, 0x31, 0x0a, 0x14, 0x56, 0x69, 0x72, 0x75, 0x73, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x76, 0x69, 0x72, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x76, 0x69, 0x72, 0x75, 0x73, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x0e, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x55, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x28, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x7b, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x55, 0x70, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x10, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x15, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xcf,
+	0x01, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x7a, 0x6f, 0x6e, 0x65, 0x5f,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x7a, 0x6f,
+	0x6e, 0x65, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x7a, 0x6f, 0x6e, 0x65,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x7a, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x22, 0x66, 0x0a, 0x15, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6c, 0x0a,
+	0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xde, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a,
-	0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x49, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x43, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x2e, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x53, 0x70, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05,
-	0x73, 0x70, 0x6f, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
-	0x73, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x40, 0x0a,
-	0x0c, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70,
-	0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x49, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x15, 0x68, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x13, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x07, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x68, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
-	0x68, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x6f, 0x77,
-	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x69,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a,
+	0x18, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73,
+	0x73, 0x22, 0x45, 0x0a, 0x11, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a,
+	0x10, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x5d, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x12,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8c, 0x01, 0x0a,
+	0x1b, 0x4c, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x16,
+	0x4c, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x52, 0x61, 0x6e,
+	0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x4d, 0x61, 0x73, 0x73, 0x22, 0x83, 0x01, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x41, 0x72, 0x65, 0x6e, 0x61,
+	0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x22, 0xbe, 0x19, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x49, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3d, 0x0a, 0x0b, 0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x44, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f,
+	0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x70, 0x6f,
+	0x72, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x70, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x70,
+	0x6f, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x65,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x73, 0x70, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x0f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x15, 0x68, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x68,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07,
+	0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c,
+	0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x68, 0x0a, 0x1a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x18, 0x66, 0x69,
 	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e, 0x67, 0x48, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x18, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x6e,
-	0x67, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42,
-	0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x68, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3c,
+	0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x05,
+	0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64, 0x65, 0x61, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x4d,
+	0x61, 0x73, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x69, 0x72, 0x75, 0x73, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x56, 0x69, 0x72,
+	0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x76, 0x69, 0x72,
+	0x75, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x76, 0x69, 0x72, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x56, 0x69, 0x72, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x69, 0x72,
+	0x75, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70,
+	0x12, 0x43, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x3d, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x4c, 0x0a, 0x10, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f,
+	0x6c, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x43, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x12, 0x43, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x53, 0x0a, 0x13, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x0d,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x40, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x12,
+	0x3d, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x2b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x3a,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x2e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a,
+	0x0b, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x05, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x2a, 0x3f, 0x0a, 0x0a, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x53, 0x68, 0x61, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x45, 0x4e, 0x41, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x43, 0x54, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x41, 0x52, 0x45, 0x4e, 0x41, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43, 0x49, 0x52, 0x43,
+	0x4c, 0x45, 0x10, 0x01, 0x2a, 0x60, 0x0a, 0x0b, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x5f, 0x42, 0x4f, 0x4f, 0x53, 0x54,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x53, 0x48, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x41,
+	0x47, 0x4e, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x55, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x2a, 0xa6, 0x01,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a,
+	0x13, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x47, 0x4c,
+	0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x52, 0x45, 0x4e, 0x41, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54,
+	0x45, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x57, 0x48, 0x49, 0x53, 0x50, 0x45, 0x52, 0x10, 0x03, 0x12,
+	0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x16,
+	0x0a, 0x12, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x59, 0x10, 0x05, 0x2a, 0xb7, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x43, 0x48, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x48, 0x41,
+	0x52, 0x41, 0x53, 0x53, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x45,
+	0x4e, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x50,
+	0x41, 0x4d, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x49, 0x45, 0x46, 0x49, 0x4e, 0x47, 0x10, 0x05,
+	0x2a, 0x62, 0x0a, 0x0c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x16, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e,
+	0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x52, 0x49, 0x45,
+	0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x46,
+	0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e,
+	0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52,
+	0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x42, 0x52, 0x4f,
+	0x57, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x53, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x41,
+	0x44, 0x10, 0x04, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1423,54 +4626,141 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_packets_proto_goTypes = []any{
-	(*ChatMessage)(nil),                     // 0: packets.ChatMessage
-	(*IdMessage)(nil),                       // 1: packets.IdMessage
-	(*LoginRequestMessage)(nil),             // 2: packets.LoginRequestMessage
-	(*RegisterRequestMessage)(nil),          // 3: packets.RegisterRequestMessage
-	(*OkResponseMessage)(nil),               // 4: packets.OkResponseMessage
-	(*DenyResponseMessage)(nil),             // 5: packets.DenyResponseMessage
-	(*PlayerMessage)(nil),                   // 6: packets.PlayerMessage
-	(*PlayerDirectionMessage)(nil),          // 7: packets.PlayerDirectionMessage
-	(*SporeMessage)(nil),                    // 8: packets.SporeMessage
-	(*SporeConsumedMessage)(nil),            // 9: packets.SporeConsumedMessage
-	(*SporesBatchMessage)(nil),              // 10: packets.SporesBatchMessage
-	(*PlayerConsumedMessage)(nil),           // 11: packets.PlayerConsumedMessage
-	(*HiscoreBoardRequestMessage)(nil),      // 12: packets.HiscoreBoardRequestMessage
-	(*HiscoreMessage)(nil),                  // 13: packets.HiscoreMessage
-	(*HiscoreBoardMessage)(nil),             // 14: packets.HiscoreBoardMessage
-	(*FinishedBrowsingHiscoresMessage)(nil), // 15: packets.FinishedBrowsingHiscoresMessage
-	(*SearchHiscoreMessage)(nil),            // 16: packets.SearchHiscoreMessage
-	(*DisconnectMessage)(nil),               // 17: packets.DisconnectMessage
-	(*Packet)(nil),                          // 18: packets.Packet
+	(ArenaShape)(0),                         // 0: packets.ArenaShape
+	(PowerUpKind)(0),                        // 1: packets.PowerUpKind
+	(RoundPhase)(0),                         // 2: packets.RoundPhase
+	(ChatChannel)(0),                        // 3: packets.ChatChannel
+	(ReportReason)(0),                       // 4: packets.ReportReason
+	(FriendStatus)(0),                       // 5: packets.FriendStatus
+	(Presence)(0),                           // 6: packets.Presence
+	(*ChatMessage)(nil),                     // 7: packets.ChatMessage
+	(*IdMessage)(nil),                       // 8: packets.IdMessage
+	(*LoginRequestMessage)(nil),             // 9: packets.LoginRequestMessage
+	(*RegisterRequestMessage)(nil),          // 10: packets.RegisterRequestMessage
+	(*GuestLoginMessage)(nil),               // 11: packets.GuestLoginMessage
+	(*OkResponseMessage)(nil),               // 12: packets.OkResponseMessage
+	(*DenyResponseMessage)(nil),             // 13: packets.DenyResponseMessage
+	(*CellMessage)(nil),                     // 14: packets.CellMessage
+	(*PlayerMessage)(nil),                   // 15: packets.PlayerMessage
+	(*PlayerDirectionMessage)(nil),          // 16: packets.PlayerDirectionMessage
+	(*SporeMessage)(nil),                    // 17: packets.SporeMessage
+	(*SporeConsumedMessage)(nil),            // 18: packets.SporeConsumedMessage
+	(*SporesBatchMessage)(nil),              // 19: packets.SporesBatchMessage
+	(*PlayerConsumedMessage)(nil),           // 20: packets.PlayerConsumedMessage
+	(*HiscoreBoardRequestMessage)(nil),      // 21: packets.HiscoreBoardRequestMessage
+	(*HiscoreMessage)(nil),                  // 22: packets.HiscoreMessage
+	(*HiscoreBoardMessage)(nil),             // 23: packets.HiscoreBoardMessage
+	(*FinishedBrowsingHiscoresMessage)(nil), // 24: packets.FinishedBrowsingHiscoresMessage
+	(*SearchHiscoreMessage)(nil),            // 25: packets.SearchHiscoreMessage
+	(*DisconnectMessage)(nil),               // 26: packets.DisconnectMessage
+	(*DeathMessage)(nil),                    // 27: packets.DeathMessage
+	(*RespawnRequestMessage)(nil),           // 28: packets.RespawnRequestMessage
+	(*SplitRequestMessage)(nil),             // 29: packets.SplitRequestMessage
+	(*EjectMassMessage)(nil),                // 30: packets.EjectMassMessage
+	(*VirusMessage)(nil),                    // 31: packets.VirusMessage
+	(*VirusConsumedMessage)(nil),            // 32: packets.VirusConsumedMessage
+	(*PowerUpMessage)(nil),                  // 33: packets.PowerUpMessage
+	(*PlayerEffectMessage)(nil),             // 34: packets.PlayerEffectMessage
+	(*TeamScoreMessage)(nil),                // 35: packets.TeamScoreMessage
+	(*TeamScoreboardMessage)(nil),           // 36: packets.TeamScoreboardMessage
+	(*RoundPhaseMessage)(nil),               // 37: packets.RoundPhaseMessage
+	(*RoundPlacementMessage)(nil),           // 38: packets.RoundPlacementMessage
+	(*RoundResultMessage)(nil),              // 39: packets.RoundResultMessage
+	(*ReportPlayerMessage)(nil),             // 40: packets.ReportPlayerMessage
+	(*FriendRequestMessage)(nil),            // 41: packets.FriendRequestMessage
+	(*FriendAcceptMessage)(nil),             // 42: packets.FriendAcceptMessage
+	(*FriendRemoveMessage)(nil),             // 43: packets.FriendRemoveMessage
+	(*FriendListRequestMessage)(nil),        // 44: packets.FriendListRequestMessage
+	(*FriendMessage)(nil),                   // 45: packets.FriendMessage
+	(*FriendListMessage)(nil),               // 46: packets.FriendListMessage
+	(*FriendOnlineMessage)(nil),             // 47: packets.FriendOnlineMessage
+	(*PartyInviteMessage)(nil),              // 48: packets.PartyInviteMessage
+	(*PartyJoinMessage)(nil),                // 49: packets.PartyJoinMessage
+	(*PartyLeaveMessage)(nil),               // 50: packets.PartyLeaveMessage
+	(*PartyKickMessage)(nil),                // 51: packets.PartyKickMessage
+	(*PartyMessage)(nil),                    // 52: packets.PartyMessage
+	(*ChangePasswordMessage)(nil),           // 53: packets.ChangePasswordMessage
+	(*ChangeNameMessage)(nil),               // 54: packets.ChangeNameMessage
+	(*ChangeColorMessage)(nil),              // 55: packets.ChangeColorMessage
+	(*DeleteAccountMessage)(nil),            // 56: packets.DeleteAccountMessage
+	(*LiveLeaderboardEntryMessage)(nil),     // 57: packets.LiveLeaderboardEntryMessage
+	(*LiveLeaderboardMessage)(nil),          // 58: packets.LiveLeaderboardMessage
+	(*WorldInfoMessage)(nil),                // 59: packets.WorldInfoMessage
+	(*Packet)(nil),                          // 60: packets.Packet
 }
 var file_packets_proto_depIdxs = []int32{
-	8,  // 0: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
-	13, // 1: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
-	0,  // 2: packets.Packet.chat:type_name -> packets.ChatMessage
-	1,  // 3: packets.Packet.id:type_name -> packets.IdMessage
-	2,  // 4: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
-	3,  // 5: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
-	4,  // 6: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
-	5,  // 7: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
-	6,  // 8: packets.Packet.player:type_name -> packets.PlayerMessage
-	7,  // 9: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
-	8,  // 10: packets.Packet.spore:type_name -> packets.SporeMessage
-	9,  // 11: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
-	10, // 12: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
-	11, // 13: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
-	12, // 14: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
-	13, // 15: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
-	14, // 16: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
-	15, // 17: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
-	16, // 18: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
-	17, // 19: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	3,  // 0: packets.ChatMessage.channel:type_name -> packets.ChatChannel
+	14, // 1: packets.PlayerMessage.cells:type_name -> packets.CellMessage
+	17, // 2: packets.SporesBatchMessage.spores:type_name -> packets.SporeMessage
+	22, // 3: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
+	1,  // 4: packets.PowerUpMessage.kind:type_name -> packets.PowerUpKind
+	1,  // 5: packets.PlayerEffectMessage.kind:type_name -> packets.PowerUpKind
+	35, // 6: packets.TeamScoreboardMessage.teams:type_name -> packets.TeamScoreMessage
+	2,  // 7: packets.RoundPhaseMessage.phase:type_name -> packets.RoundPhase
+	38, // 8: packets.RoundResultMessage.placements:type_name -> packets.RoundPlacementMessage
+	4,  // 9: packets.ReportPlayerMessage.reason:type_name -> packets.ReportReason
+	5,  // 10: packets.FriendMessage.status:type_name -> packets.FriendStatus
+	6,  // 11: packets.FriendMessage.presence:type_name -> packets.Presence
+	45, // 12: packets.FriendListMessage.friends:type_name -> packets.FriendMessage
+	57, // 13: packets.LiveLeaderboardMessage.entries:type_name -> packets.LiveLeaderboardEntryMessage
+	0,  // 14: packets.WorldInfoMessage.shape:type_name -> packets.ArenaShape
+	7,  // 15: packets.Packet.chat:type_name -> packets.ChatMessage
+	8,  // 16: packets.Packet.id:type_name -> packets.IdMessage
+	9,  // 17: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
+	10, // 18: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
+	12, // 19: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
+	13, // 20: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
+	15, // 21: packets.Packet.player:type_name -> packets.PlayerMessage
+	16, // 22: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
+	17, // 23: packets.Packet.spore:type_name -> packets.SporeMessage
+	18, // 24: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
+	19, // 25: packets.Packet.spores_batch:type_name -> packets.SporesBatchMessage
+	20, // 26: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
+	21, // 27: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
+	22, // 28: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
+	23, // 29: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
+	24, // 30: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
+	25, // 31: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
+	26, // 32: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
+	27, // 33: packets.Packet.death:type_name -> packets.DeathMessage
+	28, // 34: packets.Packet.respawn_request:type_name -> packets.RespawnRequestMessage
+	29, // 35: packets.Packet.split_request:type_name -> packets.SplitRequestMessage
+	30, // 36: packets.Packet.eject_mass:type_name -> packets.EjectMassMessage
+	59, // 37: packets.Packet.world_info:type_name -> packets.WorldInfoMessage
+	31, // 38: packets.Packet.virus:type_name -> packets.VirusMessage
+	32, // 39: packets.Packet.virus_consumed:type_name -> packets.VirusConsumedMessage
+	33, // 40: packets.Packet.power_up:type_name -> packets.PowerUpMessage
+	34, // 41: packets.Packet.player_effect:type_name -> packets.PlayerEffectMessage
+	36, // 42: packets.Packet.team_scoreboard:type_name -> packets.TeamScoreboardMessage
+	37, // 43: packets.Packet.round_phase:type_name -> packets.RoundPhaseMessage
+	39, // 44: packets.Packet.round_result:type_name -> packets.RoundResultMessage
+	58, // 45: packets.Packet.live_leaderboard:type_name -> packets.LiveLeaderboardMessage
+	40, // 46: packets.Packet.report_player:type_name -> packets.ReportPlayerMessage
+	41, // 47: packets.Packet.friend_request:type_name -> packets.FriendRequestMessage
+	42, // 48: packets.Packet.friend_accept:type_name -> packets.FriendAcceptMessage
+	43, // 49: packets.Packet.friend_remove:type_name -> packets.FriendRemoveMessage
+	44, // 50: packets.Packet.friend_list_request:type_name -> packets.FriendListRequestMessage
+	46, // 51: packets.Packet.friend_list:type_name -> packets.FriendListMessage
+	45, // 52: packets.Packet.friend:type_name -> packets.FriendMessage
+	47, // 53: packets.Packet.friend_online:type_name -> packets.FriendOnlineMessage
+	48, // 54: packets.Packet.party_invite:type_name -> packets.PartyInviteMessage
+	49, // 55: packets.Packet.party_join:type_name -> packets.PartyJoinMessage
+	50, // 56: packets.Packet.party_leave:type_name -> packets.PartyLeaveMessage
+	51, // 57: packets.Packet.party_kick:type_name -> packets.PartyKickMessage
+	52, // 58: packets.Packet.party:type_name -> packets.PartyMessage
+	53, // 59: packets.Packet.change_password:type_name -> packets.ChangePasswordMessage
+	54, // 60: packets.Packet.change_name:type_name -> packets.ChangeNameMessage
+	55, // 61: packets.Packet.change_color:type_name -> packets.ChangeColorMessage
+	56, // 62: packets.Packet.delete_account:type_name -> packets.DeleteAccountMessage
+	11, // 63: packets.Packet.guest_login:type_name -> packets.GuestLoginMessage
+	64, // [64:64] is the sub-list for method output_type
+	64, // [64:64] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -1478,7 +4768,7 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[18].OneofWrappers = []any{
+	file_packets_proto_msgTypes[53].OneofWrappers = []any{
 		(*Packet_Chat)(nil),
 		(*Packet_Id)(nil),
 		(*Packet_LoginRequest)(nil),
@@ -1497,19 +4787,51 @@ func file_packets_proto_init() {
 		(*Packet_FinishedBrowsingHiscores)(nil),
 		(*Packet_SearchHiscore)(nil),
 		(*Packet_Disconnect)(nil),
+		(*Packet_Death)(nil),
+		(*Packet_RespawnRequest)(nil),
+		(*Packet_SplitRequest)(nil),
+		(*Packet_EjectMass)(nil),
+		(*Packet_WorldInfo)(nil),
+		(*Packet_Virus)(nil),
+		(*Packet_VirusConsumed)(nil),
+		(*Packet_PowerUp)(nil),
+		(*Packet_PlayerEffect)(nil),
+		(*Packet_TeamScoreboard)(nil),
+		(*Packet_RoundPhase)(nil),
+		(*Packet_RoundResult)(nil),
+		(*Packet_LiveLeaderboard)(nil),
+		(*Packet_ReportPlayer)(nil),
+		(*Packet_FriendRequest)(nil),
+		(*Packet_FriendAccept)(nil),
+		(*Packet_FriendRemove)(nil),
+		(*Packet_FriendListRequest)(nil),
+		(*Packet_FriendList)(nil),
+		(*Packet_Friend)(nil),
+		(*Packet_FriendOnline)(nil),
+		(*Packet_PartyInvite)(nil),
+		(*Packet_PartyJoin)(nil),
+		(*Packet_PartyLeave)(nil),
+		(*Packet_PartyKick)(nil),
+		(*Packet_Party)(nil),
+		(*Packet_ChangePassword)(nil),
+		(*Packet_ChangeName)(nil),
+		(*Packet_ChangeColor)(nil),
+		(*Packet_DeleteAccount)(nil),
+		(*Packet_GuestLogin)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_packets_proto_rawDesc), len(file_packets_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      7,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_packets_proto_goTypes,
 		DependencyIndexes: file_packets_proto_depIdxs,
+		EnumInfos:         file_packets_proto_enumTypes,
 		MessageInfos:      file_packets_proto_msgTypes,
 	}.Build()
 	File_packets_proto = out.File
@@ -1,6 +1,9 @@
 package packets
 
-import "server/internal/server/objects"
+import (
+	"server/internal/server/objects"
+	"time"
+)
 
 type Msg = isPacket_Msg
 
@@ -36,16 +39,30 @@ func NewDenyResponse(reason string) Msg {
 }
 
 func NewPlayer(id uint64, player *objects.Player) Msg {
+	cellMessages := make([]*CellMessage, 0, player.Cells.Len())
+	player.Cells.ForEach(func(cellId uint64, cell *objects.Cell) {
+		cellMessages = append(cellMessages, &CellMessage{
+			Id:     cellId,
+			X:      cell.X,
+			Y:      cell.Y,
+			Radius: cell.Radius,
+		})
+	})
+
+	// Clients which don't know about cells can still draw the player as one big circle
+	x, y := player.Center()
 	return &Packet_Player{
 		Player: &PlayerMessage{
 			Id:        id,
 			Name:      player.Name,
-			X:         player.X,
-			Y:         player.Y,
-			Radius:    player.Radius,
+			X:         x,
+			Y:         y,
+			Radius:    objects.MassToRad(player.Mass()),
 			Direction: player.Direction,
 			Speed:     player.Speed,
-			Color:     player.Color,
+			Color:     player.DisplayColor(),
+			Cells:     cellMessages,
+			Team:      uint32(player.Team),
 		},
 	}
 }
@@ -56,6 +73,8 @@ func newSporeMessage(spore_id uint64, spore *objects.Spore) *SporeMessage {
 		X:      spore.X,
 		Y:      spore.Y,
 		Radius: spore.Radius,
+		VelX:   spore.VelX,
+		VelY:   spore.VelY,
 	}
 }
 
@@ -65,6 +84,24 @@ func NewSpore(id uint64, spore *objects.Spore) Msg {
 	}
 }
 
+func NewSporeConsumed(sporeId uint64, cellId uint64) Msg {
+	return &Packet_SporeConsumed{
+		SporeConsumed: &SporeConsumedMessage{
+			SporeId: sporeId,
+			CellId:  cellId,
+		},
+	}
+}
+
+func NewSporeConsumedByVirus(sporeId uint64, virusId uint64) Msg {
+	return &Packet_SporeConsumed{
+		SporeConsumed: &SporeConsumedMessage{
+			SporeId: sporeId,
+			VirusId: virusId,
+		},
+	}
+}
+
 func NewSporesBatch(spores map[uint64]*objects.Spore) Msg {
 	sporesMessages := make([]*SporeMessage, 0, len(spores))
 	for id, spore := range spores {
@@ -77,6 +114,58 @@ func NewSporesBatch(spores map[uint64]*objects.Spore) Msg {
 	}
 }
 
+func NewVirus(id uint64, virus *objects.Virus) Msg {
+	return &Packet_Virus{
+		Virus: &VirusMessage{
+			Id:     id,
+			X:      virus.X,
+			Y:      virus.Y,
+			Radius: virus.Radius,
+			VelX:   virus.VelX,
+			VelY:   virus.VelY,
+		},
+	}
+}
+
+func NewVirusConsumed(virusId uint64) Msg {
+	return &Packet_VirusConsumed{
+		VirusConsumed: &VirusConsumedMessage{
+			VirusId: virusId,
+		},
+	}
+}
+
+func NewPowerUp(id uint64, powerUp *objects.PowerUp) Msg {
+	return &Packet_PowerUp{
+		PowerUp: &PowerUpMessage{
+			Id:     id,
+			X:      powerUp.X,
+			Y:      powerUp.Y,
+			Radius: powerUp.Radius,
+			Kind:   PowerUpKind(powerUp.Kind),
+		},
+	}
+}
+
+func NewPlayerEffect(kind objects.PowerUpKind, duration time.Duration, powerUpId uint64) Msg {
+	return &Packet_PlayerEffect{
+		PlayerEffect: &PlayerEffectMessage{
+			Kind:      PowerUpKind(kind),
+			Duration:  duration.Seconds(),
+			PowerUpId: powerUpId,
+		},
+	}
+}
+
+func NewPlayerConsumed(playerId uint64, cellId uint64) Msg {
+	return &Packet_PlayerConsumed{
+		PlayerConsumed: &PlayerConsumedMessage{
+			PlayerId: playerId,
+			CellId:   cellId,
+		},
+	}
+}
+
 func NewHiscoreBoard(hiscores []*HiscoreMessage) Msg {
 	return &Packet_HiscoreBoard{
 		HiscoreBoard: &HiscoreBoardMessage{
@@ -92,3 +181,117 @@ func NewDisconnect(reason string) Msg {
 		},
 	}
 }
+
+func NewDeath(killerId uint64, killerName string, survivalTime time.Duration, peakMass float64, rank uint64, respawnDelay time.Duration) Msg {
+	return &Packet_Death{
+		Death: &DeathMessage{
+			KillerId:     killerId,
+			KillerName:   killerName,
+			SurvivalTime: survivalTime.Seconds(),
+			PeakMass:     peakMass,
+			Rank:         rank,
+			RespawnDelay: respawnDelay.Seconds(),
+		},
+	}
+}
+
+func NewWorldInfo(arena *objects.Arena) Msg {
+	shape := ArenaShape_ARENA_SHAPE_RECTANGLE
+	if arena.Shape == objects.ArenaCircle {
+		shape = ArenaShape_ARENA_SHAPE_CIRCLE
+	}
+	return &Packet_WorldInfo{
+		WorldInfo: &WorldInfoMessage{
+			Shape:  shape,
+			Width:  arena.Width,
+			Height: arena.Height,
+			Radius: arena.Radius,
+		},
+	}
+}
+
+func NewTeamScoreboard(teamScores []*TeamScoreMessage) Msg {
+	return &Packet_TeamScoreboard{
+		TeamScoreboard: &TeamScoreboardMessage{
+			Teams: teamScores,
+		},
+	}
+}
+
+func NewRoundPhase(phase RoundPhase, timeRemaining time.Duration, minPlayers int, zoneRadius float64, zoneEndRadius float64) Msg {
+	return &Packet_RoundPhase{
+		RoundPhase: &RoundPhaseMessage{
+			Phase:         phase,
+			TimeRemaining: timeRemaining.Seconds(),
+			MinPlayers:    uint32(minPlayers),
+			ZoneRadius:    zoneRadius,
+			ZoneEndRadius: zoneEndRadius,
+		},
+	}
+}
+
+func NewRoundResult(winnerId uint64, winnerName string, placements []*RoundPlacementMessage) Msg {
+	return &Packet_RoundResult{
+		RoundResult: &RoundResultMessage{
+			WinnerId:   winnerId,
+			WinnerName: winnerName,
+			Placements: placements,
+		},
+	}
+}
+
+func NewLiveLeaderboard(entries []*LiveLeaderboardEntryMessage, ownRank int, ownMass float64) Msg {
+	return &Packet_LiveLeaderboard{
+		LiveLeaderboard: &LiveLeaderboardMessage{
+			Entries: entries,
+			OwnRank: uint32(ownRank),
+			OwnMass: ownMass,
+		},
+	}
+}
+
+func NewFriendList(friends []*FriendMessage) Msg {
+	return &Packet_FriendList{
+		FriendList: &FriendListMessage{
+			Friends: friends,
+		},
+	}
+}
+
+func NewFriend(friend *FriendMessage) Msg {
+	return &Packet_Friend{
+		Friend: friend,
+	}
+}
+
+func NewFriendOnline(name string) Msg {
+	return &Packet_FriendOnline{
+		FriendOnline: &FriendOnlineMessage{
+			Name: name,
+		},
+	}
+}
+
+func NewRespawnRequest() Msg {
+	return &Packet_RespawnRequest{
+		RespawnRequest: &RespawnRequestMessage{},
+	}
+}
+
+func NewPartyInvite(name string) Msg {
+	return &Packet_PartyInvite{
+		PartyInvite: &PartyInviteMessage{
+			Name: name,
+		},
+	}
+}
+
+// An empty party means the player isn't in one any more
+func NewParty(leader string, members []string) Msg {
+	return &Packet_Party{
+		Party: &PartyMessage{
+			Leader:  leader,
+			Members: members,
+		},
+	}
+}